@@ -17,65 +17,94 @@ package arraylist
 
 import (
 	"fmt"
+	"iter"
 
 	"github.com/thinkgos/container"
 	"github.com/thinkgos/container/comparator"
 )
 
-var _ container.List = (*List)(nil)
+var _ container.ExtendedList = (*List)(nil)
 
-// List represents an array list.
+// List represents an array list of interface{} elements.
 // It implements the interface list.Interface.
-type List struct {
-	items []interface{}
-	cmp   comparator.Comparator
+type List = ListOf[interface{}]
+
+// ListOf represents an array list of T elements.
+// It implements the interface list.Interface.
+type ListOf[T any] struct {
+	items []T
+	cmp   comparator.CompareFunc[T]
+	// modCount counts the structural modifications, the list iterators use it to fail fast.
+	modCount int
+}
+
+type options struct {
+	cmp comparator.Comparator
 }
 
 // Option option for New.
-type Option func(l *List)
+type Option func(o *options)
 
 // WithComparator with user's Comparator.
 func WithComparator(cmp comparator.Comparator) Option {
-	return func(l *List) {
-		l.cmp = cmp
+	return func(o *options) {
+		o.cmp = cmp
 	}
 }
 
+// WithCompareFunc with user's CompareFunc of T elements.
+func WithCompareFunc[T any](f comparator.CompareFunc[T]) Option {
+	return WithComparator(f)
+}
+
 // New initializes and returns an ArrayList.
 func New(opts ...Option) *List {
-	l := &List{
-		items: []interface{}{},
-	}
+	return NewOf[interface{}](opts...)
+}
+
+// NewOf initializes and returns an ArrayList of T elements.
+func NewOf[T any](opts ...Option) *ListOf[T] {
+	o := options{}
 	for _, opt := range opts {
-		opt(l)
+		opt(&o)
+	}
+	return &ListOf[T]{
+		items: []T{},
+		cmp:   comparator.FuncOf[T](o.cmp),
 	}
-	return l
 }
 
 // Len returns the number of elements of list l.
 // The complexity is O(1).
-func (sf *List) Len() int { return len(sf.items) }
+func (sf *ListOf[T]) Len() int { return len(sf.items) }
 
 // IsEmpty returns the list l is empty or not.
-func (sf *List) IsEmpty() bool { return sf.Len() == 0 }
+func (sf *ListOf[T]) IsEmpty() bool { return sf.Len() == 0 }
 
 // Clear initializes or clears list l.
-func (sf *List) Clear() { sf.items = make([]interface{}, 0) }
+func (sf *ListOf[T]) Clear() {
+	sf.items = make([]T, 0)
+	sf.modCount++
+}
 
 // Push inserts a new element e with value v at the back of list l.
-func (sf *List) Push(items interface{}) { sf.items = append(sf.items, items) }
+func (sf *ListOf[T]) Push(items T) {
+	sf.items = append(sf.items, items)
+	sf.modCount++
+}
 
 // PushFront inserts a new element e with value v at the front of list l.
-func (sf *List) PushFront(v interface{}) {
+func (sf *ListOf[T]) PushFront(v T) {
 	sf.items = append(sf.items, v)
 	moveLastToFirst(sf.items)
+	sf.modCount++
 }
 
 // PushBack inserts a new element e with value v at the back of list l.
-func (sf *List) PushBack(v interface{}) { sf.items = append(sf.items, v) }
+func (sf *ListOf[T]) PushBack(v T) { sf.Push(v) }
 
 // Add inserts the specified element at the specified position in this list.
-func (sf *List) Add(index int, val interface{}) error {
+func (sf *ListOf[T]) Add(index int, val T) error {
 	if index < 0 || index > len(sf.items) {
 		return fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
 	}
@@ -87,121 +116,133 @@ func (sf *List) Add(index int, val interface{}) error {
 		sf.items = append(sf.items, val)
 		copy(sf.items[index+1:], sf.items[index:length])
 		sf.items[index] = val
+		sf.modCount++
 	}
 	return nil
 }
 
 // PushFrontList inserts a copy of an other list at the front of list l.
 // The lists l and other may be the same. They must not be nil.
-func (sf *List) PushFrontList(other *List) {
-	items := make([]interface{}, 0, len(sf.items)+len(other.items))
+func (sf *ListOf[T]) PushFrontList(other *ListOf[T]) {
+	items := make([]T, 0, len(sf.items)+len(other.items))
 	items = append(items, other.items...)
 	items = append(items, sf.items...)
 	sf.items = items
+	sf.modCount++
 }
 
 // PushBackList inserts a copy of an other list at the back of list l.
 // The lists l and other may be the same. They must not be nil.
-func (sf *List) PushBackList(other *List) {
+func (sf *ListOf[T]) PushBackList(other *ListOf[T]) {
 	sf.items = append(sf.items, other.items...)
+	sf.modCount++
 }
 
 // Poll return the front element value and then remove from list.
-func (sf *List) Poll() interface{} {
+func (sf *ListOf[T]) Poll() T {
 	return sf.PollFront()
 }
 
 // PollFront return the front element value and then remove from list.
-func (sf *List) PollFront() interface{} {
-	var val interface{}
+func (sf *ListOf[T]) PollFront() (val T) {
+	var zero T
 
 	if n := len(sf.items); n > 0 {
 		moveFirstToLast(sf.items)
 		val = sf.items[n-1]
-		sf.items[n-1] = nil // for gc
+		sf.items[n-1] = zero // for gc
 		sf.items = sf.items[:n-1]
+		sf.modCount++
 	}
 	return val
 }
 
 // PollBack return the back element value and then remove from list.
-func (sf *List) PollBack() interface{} {
-	var val interface{}
+func (sf *ListOf[T]) PollBack() (val T) {
+	var zero T
 
 	if n := len(sf.items); n > 0 {
 		val = sf.items[n-1]
-		sf.items[n-1] = nil // for gc
+		sf.items[n-1] = zero // for gc
 		sf.items = sf.items[:n-1]
+		sf.modCount++
 	}
 	return val
 }
 
 // Remove removes the element at the specified position in this list.
 // It returns an error if the index is out of range.
-func (sf *List) Remove(index int) (interface{}, error) {
+func (sf *ListOf[T]) Remove(index int) (T, error) {
+	var zero T
+
 	if index < 0 || index >= len(sf.items) {
-		return nil, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
+		return zero, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
 	}
 
 	val := sf.items[index]
 	// sf.items = append(sf.items[:index], sf.items[(index+1):]...)
-	moveLastToFirst(sf.items[index:])
-	sf.items[len(sf.items)-1] = nil
+	moveFirstToLast(sf.items[index:])
+	sf.items[len(sf.items)-1] = zero
 	sf.items = sf.items[:len(sf.items)-1]
 	sf.shrinkList()
+	sf.modCount++
 	return val, nil
 }
 
 // RemoveValue removes the first occurrence of the specified element from this list, if it is present.
 // It returns false if the target value isn't present, otherwise returns true.
-func (sf *List) RemoveValue(val interface{}) bool {
+func (sf *ListOf[T]) RemoveValue(val T) bool {
+	var zero T
+
 	if sf.Len() == 0 {
 		return false
 	}
 
 	if idx := sf.indexOf(val); idx >= 0 {
 		// sf.items = append(sf.items[:idx], sf.items[(idx+1):]...)
-		moveLastToFirst(sf.items[idx:])
-		sf.items[len(sf.items)-1] = nil
+		moveFirstToLast(sf.items[idx:])
+		sf.items[len(sf.items)-1] = zero
 		sf.items = sf.items[:len(sf.items)-1]
 		sf.shrinkList()
+		sf.modCount++
 		return true
 	}
 	return false
 }
 
 // Get returns the element at the specified position in this list. The index must be in the range of [0, size).
-func (sf *List) Get(index int) (interface{}, error) {
+func (sf *ListOf[T]) Get(index int) (T, error) {
 	if index < 0 || index >= len(sf.items) {
-		return nil, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
+		var zero T
+		return zero, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
 	}
 
 	return sf.items[index], nil
 }
 
 // Peek return the front element value.
-func (sf *List) Peek() interface{} {
+func (sf *ListOf[T]) Peek() T {
 	return sf.PeekFront()
 }
 
 // PeekFront return the front element value.
-func (sf *List) PeekFront() interface{} {
+func (sf *ListOf[T]) PeekFront() (val T) {
 	if len(sf.items) > 0 {
 		return sf.items[0]
 	}
-	return nil
+	return val
 }
 
 // PeekBack return the back element value.
-func (sf *List) PeekBack() interface{} {
+func (sf *ListOf[T]) PeekBack() (val T) {
 	if len(sf.items) > 0 {
 		return sf.items[len(sf.items)-1]
 	}
-	return nil
+	return val
 }
 
 // Iterator returns an iterator over the elements in this list in proper sequence.
-func (sf *List) Iterator(f func(interface{}) bool) {
+func (sf *ListOf[T]) Iterator(f func(T) bool) {
 	for index := 0; index < sf.Len(); index++ {
 		if f == nil || !f(sf.items[index]) {
 			return
@@ -210,7 +251,7 @@ func (sf *List) Iterator(f func(interface{}) bool) {
 }
 
 // ReverseIterator returns an iterator over the elements in this list in reverse sequence as Iterator.
-func (sf *List) ReverseIterator(f func(interface{}) bool) {
+func (sf *ListOf[T]) ReverseIterator(f func(T) bool) {
 	for index := sf.Len() - 1; index >= 0; index-- {
 		if f == nil || !f(sf.items[index]) {
 			return
@@ -218,30 +259,37 @@ func (sf *List) ReverseIterator(f func(interface{}) bool) {
 	}
 }
 
+// All returns an iterator over the elements in this list in proper sequence.
+func (sf *ListOf[T]) All() iter.Seq[T] { return sf.Iterator }
+
+// Backward returns an iterator over the elements in this list in reverse sequence.
+func (sf *ListOf[T]) Backward() iter.Seq[T] { return sf.ReverseIterator }
+
 // Contains contains the value.
-func (sf *List) Contains(val interface{}) bool {
-	return val != nil && sf.indexOf(val) >= 0
+func (sf *ListOf[T]) Contains(val T) bool {
+	return any(val) != nil && sf.indexOf(val) >= 0
 }
 
 // Sort sort the list.
-func (sf *List) Sort(reverse ...bool) {
+func (sf *ListOf[T]) Sort(reverse ...bool) {
 	if sf.Len() <= 1 {
 		return
 	}
-	comparator.Sort(sf.items, sf.cmp, reverse...)
+	comparator.SortOf(sf.items, sf.cmp, reverse...)
+	sf.modCount++
 }
 
 // Values get a copy of all the values in the list.
-func (sf *List) Values() []interface{} {
-	items := make([]interface{}, 0, len(sf.items))
+func (sf *ListOf[T]) Values() []T {
+	items := make([]T, 0, len(sf.items))
 	items = append(items, sf.items...)
 	return items
 }
 
-func (sf *List) shrinkList() {
+func (sf *ListOf[T]) shrinkList() {
 	oldLen, oldCap := len(sf.items), cap(sf.items)
 	if oldCap > 1024 && oldLen <= oldCap/4 { // shrink when len(list) <= cap(list)/4
-		newItems := make([]interface{}, oldLen)
+		newItems := make([]T, oldLen)
 		copy(newItems, sf.items)
 		sf.Clear()
 		sf.items = newItems
@@ -250,7 +298,7 @@ func (sf *List) shrinkList() {
 
 // indexOf returns the index of the first occurrence of the specified element
 // in this list, or -1 if this list does not contain the element.
-func (sf *List) indexOf(val interface{}) int {
+func (sf *ListOf[T]) indexOf(val T) int {
 	for i, v := range sf.items {
 		if sf.compare(v, val) {
 			return i
@@ -259,20 +307,20 @@ func (sf *List) indexOf(val interface{}) int {
 	return -1
 }
 
-func (sf *List) compare(v1, v2 interface{}) bool {
+func (sf *ListOf[T]) compare(v1, v2 T) bool {
 	if sf.cmp != nil {
-		return sf.cmp.Compare(v1, v2) == 0
+		return sf.cmp(v1, v2) == 0
 	}
-	return v1 == v2
+	return any(v1) == any(v2)
 }
 
-func moveLastToFirst(items []interface{}) {
+func moveLastToFirst[T any](items []T) {
 	for i := 0; i < len(items); i++ {
 		items[i], items[len(items)-1] = items[len(items)-1], items[i]
 	}
 }
 
-func moveFirstToLast(items []interface{}) {
+func moveFirstToLast[T any](items []T) {
 	for i := 0; i < len(items); i++ {
 		items[0], items[len(items)-1-i] = items[len(items)-1-i], items[0]
 	}
@@ -1,10 +1,13 @@
 package arraylist
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
 )
 
 func checkList(t *testing.T, l *List, es []interface{}) {
@@ -39,6 +42,23 @@ func TestArrayListLen(t *testing.T) {
 	assert.True(t, l.IsEmpty())
 }
 
+func TestArrayListRemove(t *testing.T) {
+	l := New()
+	for i := 0; i < 5; i++ {
+		l.PushBack(i)
+	}
+
+	// remove the element at the position 1, the elements after it are shifted left
+	v, err := l.Remove(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, v)
+	checkList(t, l, []interface{}{0, 2, 3, 4})
+
+	// remove the first occurrence of 2
+	assert.True(t, l.RemoveValue(2))
+	checkList(t, l, []interface{}{0, 3, 4})
+}
+
 func TestArrayListValue(t *testing.T) {
 	l := New()
 	l.Push(5)
@@ -274,3 +294,60 @@ func (aln *arrayListNode) Compare(v1, v2 interface{}) int {
 
 	return 1
 }
+
+func TestArrayListOf(t *testing.T) {
+	var l container.ListOf[int] = NewOf[int]()
+	l.PushBack(5)
+	l.PushBack(7)
+	l.PushFront(6)
+	require.NoError(t, l.Add(3, 9))
+
+	assert.Equal(t, []int{6, 5, 7, 9}, l.Values())
+	assert.True(t, l.Contains(9))
+	assert.True(t, l.RemoveValue(9))
+	assert.False(t, l.Contains(9))
+
+	l.Sort()
+	assert.Equal(t, []int{5, 6, 7}, l.Values())
+	l.Sort(true)
+	assert.Equal(t, []int{7, 6, 5}, l.Values())
+
+	v, err := l.Get(10)
+	assert.Error(t, err)
+	assert.Zero(t, v)
+	assert.Equal(t, 7, l.PollFront())
+	assert.Equal(t, 5, l.PollBack())
+	assert.Equal(t, 6, l.Poll())
+	assert.Zero(t, l.Poll())
+	assert.Zero(t, l.PeekBack())
+
+	// with compare function
+	sl := NewOf[string](WithCompareFunc(func(s1, s2 string) int { return len(s1) - len(s2) }))
+	sl.Push("ccc")
+	sl.Push("a")
+	sl.Push("bb")
+	assert.True(t, sl.Contains("zz"))
+	sl.Sort()
+	assert.Equal(t, []string{"a", "bb", "ccc"}, sl.Values())
+	assert.Panics(t, func() { NewOf[string](WithCompareFunc(func(i1, i2 int) int { return i1 - i2 })) },
+		"a CompareFunc of other elements should panic at construction")
+}
+
+func TestArrayListAll(t *testing.T) {
+	l := NewOf[int]()
+	for i := 1; i <= 5; i++ {
+		l.PushBack(i)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(l.All()))
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, slices.Collect(l.Backward()))
+
+	var got []int
+	for v := range l.All() {
+		if v > 3 {
+			break
+		}
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, got)
+	assert.Empty(t, slices.Collect(NewOf[int]().All()))
+}
@@ -0,0 +1,151 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arraylist
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/thinkgos/container"
+)
+
+// Set replaces the element at the specified position in this list with val, it returns the old element.
+// It returns an error if the index is out of range.
+func (sf *ListOf[T]) Set(index int, val T) (old T, err error) {
+	if index < 0 || index >= len(sf.items) {
+		return old, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
+	}
+	old, sf.items[index] = sf.items[index], val
+	return old, nil
+}
+
+// IndexOf returns the index of the first occurrence of the specified element, or -1 if it isn't present.
+func (sf *ListOf[T]) IndexOf(val T) int { return sf.indexOf(val) }
+
+// LastIndexOf returns the index of the last occurrence of the specified element, or -1 if it isn't present.
+func (sf *ListOf[T]) LastIndexOf(val T) int {
+	for i := len(sf.items) - 1; i >= 0; i-- {
+		if sf.compare(sf.items[i], val) {
+			return i
+		}
+	}
+	return -1
+}
+
+// SubList returns a new list with a copy of the elements in the range of [from, to).
+// It returns an error if the range is out of range.
+func (sf *ListOf[T]) SubList(from, to int) (container.ExtendedListOf[T], error) {
+	if from < 0 || to > len(sf.items) || from > to {
+		return nil, fmt.Errorf("range out of range, from:%d, to:%d, len:%d", from, to, sf.Len())
+	}
+	return &ListOf[T]{
+		items: append(make([]T, 0, to-from), sf.items[from:to]...),
+		cmp:   sf.cmp,
+	}, nil
+}
+
+// AddAll inserts the specified elements at the specified position in this list in order.
+// It returns an error if the index is out of range.
+func (sf *ListOf[T]) AddAll(index int, vals ...T) error {
+	if index < 0 || index > len(sf.items) {
+		return fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
+	}
+	if len(vals) > 0 {
+		sf.items = slices.Insert(sf.items, index, vals...)
+		sf.modCount++
+	}
+	return nil
+}
+
+// RemoveIf removes all of the elements that satisfy the given predicate in a single compaction pass.
+// It returns the number of the removed elements.
+func (sf *ListOf[T]) RemoveIf(pred func(T) bool) int {
+	n := len(sf.items)
+	sf.items = slices.DeleteFunc(sf.items, pred) // the tail is set zero for gc
+	removed := n - len(sf.items)
+	if removed > 0 {
+		sf.shrinkList()
+		sf.modCount++
+	}
+	return removed
+}
+
+// RetainAll retains only the elements which are equal to one of vals.
+// It returns the number of the removed elements.
+func (sf *ListOf[T]) RetainAll(vals ...T) int {
+	return sf.RemoveIf(func(v T) bool {
+		return !slices.ContainsFunc(vals, func(val T) bool { return sf.compare(v, val) })
+	})
+}
+
+// ReplaceAll replaces each element with the result of fn applied to it.
+func (sf *ListOf[T]) ReplaceAll(fn func(T) T) {
+	for i, v := range sf.items {
+		sf.items[i] = fn(v)
+	}
+}
+
+// Reverse reverses the order of the elements.
+func (sf *ListOf[T]) Reverse() {
+	if len(sf.items) > 1 {
+		slices.Reverse(sf.items)
+		sf.modCount++
+	}
+}
+
+// Swap swaps the elements at the specified positions.
+// It returns an error if any index is out of range.
+func (sf *ListOf[T]) Swap(i, j int) error {
+	if i < 0 || i >= len(sf.items) || j < 0 || j >= len(sf.items) {
+		return fmt.Errorf("index out of range, i:%d, j:%d, len:%d", i, j, sf.Len())
+	}
+	sf.items[i], sf.items[j] = sf.items[j], sf.items[i]
+	return nil
+}
+
+// Rotate rotates the elements by the specified distance in place,
+// the element at index i moves to index (i + distance) mod size, distance may be negative.
+func (sf *ListOf[T]) Rotate(distance int) {
+	n := len(sf.items)
+	if n == 0 {
+		return
+	}
+	if d := (distance%n + n) % n; d > 0 {
+		slices.Reverse(sf.items)
+		slices.Reverse(sf.items[:d])
+		slices.Reverse(sf.items[d:])
+		sf.modCount++
+	}
+}
+
+// Clone returns a shallow copy of this list, the comparator is kept.
+func (sf *ListOf[T]) Clone() container.ExtendedListOf[T] {
+	return &ListOf[T]{items: sf.Values(), cmp: sf.cmp}
+}
+
+// Equal returns true if other has the same elements in the same order,
+// the elements are compared by the comparator of this list.
+func (sf *ListOf[T]) Equal(other container.ListOf[T]) bool {
+	if other == nil || sf.Len() != other.Len() {
+		return false
+	}
+	i, equal := 0, true
+	other.Iterator(func(v T) bool {
+		equal = sf.compare(sf.items[i], v)
+		i++
+		return equal
+	})
+	return equal
+}
@@ -0,0 +1,54 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arraylist
+
+import (
+	"github.com/thinkgos/container"
+)
+
+// MarshalJSON implement json.Marshaler, the elements are encoded as a JSON array in proper sequence.
+// The elements of interface type are encoded as container.JSONValue.
+func (sf *ListOf[T]) MarshalJSON() ([]byte, error) { return container.MarshalJSONValues(sf.items) }
+
+// UnmarshalJSON implement json.Unmarshaler, it replaces the elements of this list, the comparator is kept.
+func (sf *ListOf[T]) UnmarshalJSON(data []byte) error {
+	items, err := container.UnmarshalJSONValues[T](data)
+	if err != nil {
+		return err
+	}
+	sf.items = items
+	sf.modCount++
+	return nil
+}
+
+// MarshalBinary implement encoding.BinaryMarshaler, the elements are encoded by gob in proper sequence.
+func (sf *ListOf[T]) MarshalBinary() ([]byte, error) { return container.GobEncode(sf.items) }
+
+// UnmarshalBinary implement encoding.BinaryUnmarshaler, it replaces the elements of this list, the comparator is kept.
+func (sf *ListOf[T]) UnmarshalBinary(data []byte) error {
+	var items []T
+	if err := container.GobDecode(data, &items); err != nil {
+		return err
+	}
+	sf.items = items
+	sf.modCount++
+	return nil
+}
+
+// GobEncode implement gob.GobEncoder.
+func (sf *ListOf[T]) GobEncode() ([]byte, error) { return sf.MarshalBinary() }
+
+// GobDecode implement gob.GobDecoder.
+func (sf *ListOf[T]) GobDecode(data []byte) error { return sf.UnmarshalBinary(data) }
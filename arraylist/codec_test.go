@@ -0,0 +1,64 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arraylist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCodec(t *testing.T) {
+	l := New()
+	l.Push(1)
+	l.Push("hello")
+	l.Push(int64(2))
+	l.Push(nil)
+	l.Push(1.5)
+
+	data, err := json.Marshal(l)
+	require.NoError(t, err)
+	got := New()
+	require.NoError(t, json.Unmarshal(data, got))
+	assert.Equal(t, l.Values(), got.Values())
+
+	data, err = l.MarshalBinary()
+	require.NoError(t, err)
+	got = New()
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, l.Values(), got.Values())
+
+	// gob with the zero value
+	li := NewOf[int]()
+	li.Push(3)
+	li.Push(1)
+	li.Push(2)
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(li))
+	var gotInt ListOf[int]
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&gotInt))
+	assert.Equal(t, []int{3, 1, 2}, gotInt.Values())
+
+	data, err = json.Marshal(li)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[3,1,2]`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), got))
+	assert.Error(t, got.UnmarshalBinary([]byte("bad")))
+}
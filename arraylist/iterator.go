@@ -0,0 +1,148 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arraylist
+
+import (
+	"fmt"
+
+	"github.com/thinkgos/container"
+)
+
+var _ container.ListIterator = (*listIterator[interface{}])(nil)
+
+// listIterator is the list iterator of ListOf.
+type listIterator[T any] struct {
+	l *ListOf[T]
+	// cursor is the index of the element returned by Next.
+	cursor int
+	// last is the index of the element last returned by Next or Prev, -1 if none.
+	last     int
+	modCount int
+}
+
+// ListIterator returns a bidirectional cursor over the elements in this list, starting from the front.
+func (sf *ListOf[T]) ListIterator() container.ListIteratorOf[T] {
+	return &listIterator[T]{l: sf, last: -1, modCount: sf.modCount}
+}
+
+// ListIteratorAt returns a bidirectional cursor over the elements in this list, starting from the index.
+// The index must be in the range of [0, size], the first Next returns the element at the index.
+func (sf *ListOf[T]) ListIteratorAt(index int) (container.ListIteratorOf[T], error) {
+	if index < 0 || index > sf.Len() {
+		return nil, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
+	}
+	return &listIterator[T]{l: sf, cursor: index, last: -1, modCount: sf.modCount}, nil
+}
+
+// HasNext implement container.ListIterator.
+func (it *listIterator[T]) HasNext() bool { return it.cursor < it.l.Len() }
+
+// Next implement container.ListIterator.
+func (it *listIterator[T]) Next() (val T, err error) {
+	if err = it.check(); err != nil {
+		return val, err
+	}
+	if it.cursor >= it.l.Len() {
+		return val, container.ErrNoSuchElement
+	}
+	it.last = it.cursor
+	it.cursor++
+	return it.l.items[it.last], nil
+}
+
+// HasPrev implement container.ListIterator.
+func (it *listIterator[T]) HasPrev() bool { return it.cursor > 0 }
+
+// Prev implement container.ListIterator.
+func (it *listIterator[T]) Prev() (val T, err error) {
+	if err = it.check(); err != nil {
+		return val, err
+	}
+	if it.cursor <= 0 {
+		return val, container.ErrNoSuchElement
+	}
+	it.cursor--
+	it.last = it.cursor
+	return it.l.items[it.last], nil
+}
+
+// NextIndex implement container.ListIterator.
+func (it *listIterator[T]) NextIndex() int { return it.cursor }
+
+// PrevIndex implement container.ListIterator.
+func (it *listIterator[T]) PrevIndex() int { return it.cursor - 1 }
+
+// Set implement container.ListIterator.
+func (it *listIterator[T]) Set(v T) error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last < 0 {
+		return container.ErrIllegalState
+	}
+	it.l.items[it.last] = v
+	return nil
+}
+
+// Remove implement container.ListIterator.
+func (it *listIterator[T]) Remove() error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last < 0 {
+		return container.ErrIllegalState
+	}
+	if _, err := it.l.Remove(it.last); err != nil {
+		return err
+	}
+	if it.last < it.cursor {
+		it.cursor--
+	}
+	it.last = -1
+	it.modCount = it.l.modCount
+	return nil
+}
+
+// InsertBefore implement container.ListIterator.
+func (it *listIterator[T]) InsertBefore(v T) error {
+	if err := it.insert(v); err != nil {
+		return err
+	}
+	it.cursor++
+	return nil
+}
+
+// InsertAfter implement container.ListIterator.
+func (it *listIterator[T]) InsertAfter(v T) error { return it.insert(v) }
+
+// insert inserts v at the cursor.
+func (it *listIterator[T]) insert(v T) error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if err := it.l.Add(it.cursor, v); err != nil {
+		return err
+	}
+	it.last = -1
+	it.modCount = it.l.modCount
+	return nil
+}
+
+func (it *listIterator[T]) check() error {
+	if it.modCount != it.l.modCount {
+		return container.ErrConcurrentModification
+	}
+	return nil
+}
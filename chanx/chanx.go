@@ -0,0 +1,84 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chanx adapts the containers to channels.
+// The adapters turn a Queue or a Stack into an unbounded channel pair, a goroutine pumps the elements
+// from the in channel into the container and from the container to the out channel,
+// so the output is ordered by the container, a priority queue yields a priority channel.
+package chanx
+
+import (
+	"context"
+
+	"github.com/thinkgos/container"
+)
+
+// FromQueue turns q into an unbounded channel pair, the elements sent to in are added to q,
+// and the elements polled from q are received from out, in the order of q.
+// The container must not be accessed by others until out is closed.
+//
+// When in is closed, the remaining elements are drained to out, then out is closed.
+// When ctx is done, in is no longer received, the remaining elements are drained to out, then out is closed,
+// so the senders should give up on ctx done too, and the receiver should receive until out is closed.
+func FromQueue[T any](ctx context.Context, q container.QueueOf[T]) (in chan<- T, out <-chan T) {
+	return adapt(ctx, q.Add, q.Poll, q.Peek, q.Len)
+}
+
+// FromStack turns s into an unbounded channel pair, the elements sent to in are pushed to s,
+// and the elements popped from s are received from out, last-in-first-out.
+// The container must not be accessed by others until out is closed.
+//
+// When in is closed, the remaining elements are drained to out, then out is closed.
+// When ctx is done, in is no longer received, the remaining elements are drained to out, then out is closed,
+// so the senders should give up on ctx done too, and the receiver should receive until out is closed.
+func FromStack[T any](ctx context.Context, s container.StackOf[T]) (in chan<- T, out <-chan T) {
+	return adapt(ctx, s.Push, s.Pop, s.Peek, s.Len)
+}
+
+func adapt[T any](ctx context.Context, push func(T), pop, peek func() T, size func() int) (chan<- T, <-chan T) {
+	in, out := make(chan T), make(chan T)
+	go pump(ctx, in, out, push, pop, peek, size)
+	return in, out
+}
+
+// pump moves the elements from in into the container and from the container to out,
+// the head of the container is offered to out until it is received or a new element arrives,
+// then the new head is offered.
+// Once ctx is done, in is no longer received and the remaining elements are drained to out.
+func pump[T any](ctx context.Context, in <-chan T, out chan<- T, push func(T), pop, peek func() T, size func() int) {
+	defer close(out)
+	for in != nil || size() > 0 {
+		var outCh chan<- T
+		var head T
+
+		if size() > 0 {
+			outCh, head = out, peek()
+		}
+		select {
+		case v, ok := <-in:
+			if !ok {
+				in = nil // drain the remaining elements
+				continue
+			}
+			push(v)
+		case outCh <- head:
+			pop()
+		case <-ctx.Done():
+			for size() > 0 {
+				out <- pop()
+			}
+			return
+		}
+	}
+}
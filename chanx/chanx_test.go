@@ -0,0 +1,119 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chanx
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container/priorityqueue"
+	"github.com/thinkgos/container/queue"
+	"github.com/thinkgos/container/stack"
+)
+
+func collect[T any](out <-chan T) []T {
+	var values []T
+	for v := range out {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestFromQueue(t *testing.T) {
+	in, out := FromQueue[int](context.Background(), queue.NewQuickQueueOf[int]())
+	for i := 0; i < 1000; i++ { // unbounded, never block without a receiver
+		in <- i
+	}
+	close(in)
+	values := collect(out)
+	require.Len(t, values, 1000)
+	for i, v := range values {
+		assert.Equal(t, i, v)
+	}
+}
+
+func TestFromPriorityQueue(t *testing.T) {
+	in, out := FromQueue[int](context.Background(), priorityqueue.NewOf[int](priorityqueue.WithMaxHeap(true)))
+	for _, v := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		in <- v
+	}
+	close(in)
+	assert.Equal(t, []int{9, 6, 5, 4, 3, 2, 1, 1}, collect(out))
+}
+
+func TestFromStack(t *testing.T) {
+	in, out := FromStack[string](context.Background(), stack.NewQuickStackOf[string]())
+	in <- "a"
+	in <- "b"
+	in <- "c"
+	close(in)
+	assert.Equal(t, []string{"c", "b", "a"}, collect(out))
+
+	// interleaved
+	in, out = FromStack[string](context.Background(), stack.NewOf[string]())
+	in <- "a"
+	assert.Equal(t, "a", <-out)
+	in <- "b"
+	in <- "c"
+	assert.Equal(t, "c", <-out)
+	close(in)
+	assert.Equal(t, []string{"b"}, collect(out))
+}
+
+func TestContextShutdown(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	q := queue.NewOf[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	in, out := FromQueue[int](ctx, q)
+	in <- 1
+	in <- 2
+	in <- 3
+	assert.Equal(t, 1, <-out)
+	cancel()
+
+	// in is left open, the remaining elements are drained to out, then out is closed
+	var received []int
+	timeout := time.After(time.Second)
+	for done := false; !done; {
+		select {
+		case v, ok := <-out:
+			if ok {
+				received = append(received, v)
+			} else {
+				done = true
+			}
+		case <-timeout:
+			t.Fatal("out is not closed")
+		}
+	}
+	assert.Equal(t, []int{2, 3}, received)
+	assert.Zero(t, q.Len())
+
+	select {
+	case in <- 4:
+		t.Fatal("in is received after ctx is done")
+	case <-time.After(10 * time.Millisecond):
+	}
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > goroutines; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("the pump goroutine does not exit without closing in")
+		}
+	}
+}
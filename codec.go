@@ -0,0 +1,195 @@
+package container
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// registry of the types for decoding the elements of interface type.
+var (
+	registryMu sync.RWMutex
+	nameToType = make(map[string]reflect.Type)
+	typeToName = make(map[reflect.Type]string)
+)
+
+func init() {
+	for _, v := range []interface{}{
+		false,
+		int(0), int8(0), int16(0), int32(0), int64(0),
+		uint(0), uint8(0), uint16(0), uint32(0), uint64(0), uintptr(0),
+		float32(0), float64(0),
+		"",
+		[]byte(nil), []interface{}(nil), map[string]interface{}(nil),
+	} {
+		registerName(reflect.TypeOf(v).String(), reflect.TypeOf(v))
+	}
+}
+
+// Register records a type, identified by a value for the type, under its internal type name,
+// the name is the same as gob.Register. It is used to decode the elements of interface type,
+// like the elements of the interface{} containers. the basic types are registered already.
+// It registers the type to gob too.
+func Register(value interface{}) {
+	rt := reflect.TypeOf(value)
+	name := rt.String()
+
+	// same as gob.Register
+	star := ""
+	if rt.Name() == "" {
+		if pt := rt; pt.Kind() == reflect.Pointer {
+			star = "*"
+			rt = pt
+		}
+	}
+	if rt.Name() != "" {
+		if rt.PkgPath() == "" {
+			name = star + rt.Name()
+		} else {
+			name = star + rt.PkgPath() + "." + rt.Name()
+		}
+	}
+	RegisterName(name, value)
+}
+
+// RegisterName is like Register but uses the provided name rather than the type's default.
+// It panics if the type or the name is registered already with the other one.
+func RegisterName(name string, value interface{}) {
+	if name == "" {
+		panic("container: attempt to register empty name")
+	}
+	registerName(name, reflect.TypeOf(value))
+	gob.RegisterName(name, value)
+}
+
+func registerName(name string, rt reflect.Type) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if t, dup := nameToType[name]; dup && t != rt {
+		panic(fmt.Sprintf("container: registering duplicate types for %q: %s != %s", name, t, rt))
+	}
+	if n, dup := typeToName[rt]; dup && n != name {
+		panic(fmt.Sprintf("container: registering duplicate names for %s: %q != %q", rt, n, name))
+	}
+	nameToType[name] = rt
+	typeToName[rt] = name
+}
+
+func lookupName(rt reflect.Type) (string, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	name, ok := typeToName[rt]
+	return name, ok
+}
+
+func lookupType(name string) (reflect.Type, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	rt, ok := nameToType[name]
+	return rt, ok
+}
+
+// JSONValue wraps a value of T for JSON encoding.
+// If T is an interface type, the value is encoded with the registered name of its dynamic type,
+// as {"type": name, "value": value}, so it can be decoded to the same type, a nil value is encoded as null.
+// Otherwise the value is encoded as it is.
+type JSONValue[T any] struct {
+	V T
+}
+
+type jsonEnvelope struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalJSON implement json.Marshaler.
+func (sf JSONValue[T]) MarshalJSON() ([]byte, error) {
+	if reflect.TypeFor[T]().Kind() != reflect.Interface {
+		return json.Marshal(sf.V)
+	}
+
+	if any(sf.V) == nil {
+		return []byte("null"), nil
+	}
+	rt := reflect.TypeOf(sf.V)
+	name, ok := lookupName(rt)
+	if !ok {
+		return nil, fmt.Errorf("container: type not registered: %s", rt)
+	}
+	value, err := json.Marshal(sf.V)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonEnvelope{name, value})
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (sf *JSONValue[T]) UnmarshalJSON(data []byte) error {
+	tt := reflect.TypeFor[T]()
+	if tt.Kind() != reflect.Interface {
+		return json.Unmarshal(data, &sf.V)
+	}
+
+	var zero T
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		sf.V = zero
+		return nil
+	}
+	var env jsonEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return err
+	}
+	rt, ok := lookupType(env.Type)
+	if !ok {
+		return fmt.Errorf("container: name not registered: %q", env.Type)
+	}
+	if !rt.AssignableTo(tt) {
+		return fmt.Errorf("container: type %s is not assignable to %s", rt, tt)
+	}
+	pv := reflect.New(rt)
+	if err := json.Unmarshal(env.Value, pv.Interface()); err != nil {
+		return err
+	}
+	reflect.ValueOf(&sf.V).Elem().Set(pv.Elem())
+	return nil
+}
+
+// MarshalJSONValues returns the JSON array encoding of values, each value is encoded as JSONValue.
+func MarshalJSONValues[T any](values []T) ([]byte, error) {
+	vs := make([]JSONValue[T], len(values))
+	for i, v := range values {
+		vs[i].V = v
+	}
+	return json.Marshal(vs)
+}
+
+// UnmarshalJSONValues parses the JSON array encoded by MarshalJSONValues.
+func UnmarshalJSONValues[T any](data []byte) ([]T, error) {
+	var vs []JSONValue[T]
+	if err := json.Unmarshal(data, &vs); err != nil {
+		return nil, err
+	}
+	values := make([]T, len(vs))
+	for i, v := range vs {
+		values[i] = v.V
+	}
+	return values, nil
+}
+
+// GobEncode returns the gob encoding of v, the dynamic types of interface values must be registered,
+// by Register or gob.Register.
+func GobEncode(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode parses the gob encoded data and stores the result in the value pointed to by v.
+func GobDecode(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
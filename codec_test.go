@@ -0,0 +1,84 @@
+package container
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type point struct {
+	X, Y int
+}
+
+func (p point) String() string { return fmt.Sprintf("(%d,%d)", p.X, p.Y) }
+
+type unregistered struct{ A int }
+
+func init() {
+	Register(point{})
+}
+
+func TestRegister(t *testing.T) {
+	name, ok := lookupName(reflect.TypeOf(point{}))
+	require.True(t, ok)
+	assert.Equal(t, "github.com/thinkgos/container.point", name)
+
+	assert.NotPanics(t, func() { Register(point{}) })
+	assert.Panics(t, func() { RegisterName("other", point{}) })
+	assert.Panics(t, func() { RegisterName("int", point{}) })
+	assert.Panics(t, func() { RegisterName("", point{}) })
+}
+
+func TestJSONValues(t *testing.T) {
+	values := []interface{}{1, "a", 1.5, true, nil, int64(2), uint8(3), point{1, 2}}
+	data, err := MarshalJSONValues(values)
+	require.NoError(t, err)
+	got, err := UnmarshalJSONValues[interface{}](data)
+	require.NoError(t, err)
+	assert.Equal(t, values, got)
+
+	// concrete type is encoded as it is
+	data, err = MarshalJSONValues([]int{1, 2, 3})
+	require.NoError(t, err)
+	assert.JSONEq(t, `[1,2,3]`, string(data))
+	ints, err := UnmarshalJSONValues[int](data)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, ints)
+
+	// non-empty interface
+	data, err = MarshalJSONValues([]fmt.Stringer{point{5, 6}})
+	require.NoError(t, err)
+	stringers, err := UnmarshalJSONValues[fmt.Stringer](data)
+	require.NoError(t, err)
+	assert.Equal(t, []fmt.Stringer{point{5, 6}}, stringers)
+	_, err = UnmarshalJSONValues[fmt.Stringer]([]byte(`[{"type":"int","value":1}]`))
+	assert.Error(t, err)
+
+	_, err = MarshalJSONValues([]interface{}{unregistered{}})
+	assert.Error(t, err)
+	_, err = UnmarshalJSONValues[interface{}]([]byte(`[{"type":"unknown","value":1}]`))
+	assert.Error(t, err)
+	_, err = UnmarshalJSONValues[interface{}]([]byte(`{`))
+	assert.Error(t, err)
+
+	var v JSONValue[interface{}]
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"string","value":"hi"}`), &v))
+	assert.Equal(t, "hi", v.V)
+}
+
+func TestGob(t *testing.T) {
+	values := []interface{}{1, "a", nil, point{1, 2}}
+	data, err := GobEncode(values)
+	require.NoError(t, err)
+	var got []interface{}
+	require.NoError(t, GobDecode(data, &got))
+	assert.Equal(t, values, got)
+
+	_, err = GobEncode([]interface{}{unregistered{}})
+	assert.Error(t, err)
+	assert.Error(t, GobDecode([]byte("bad"), &got))
+}
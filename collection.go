@@ -1,7 +1,26 @@
 package container
 
-// Stack is a Stack interface, which is LIFO (last-in-first-out).
-type Stack interface {
+import (
+	"errors"
+)
+
+// Errors of ListIterator.
+var (
+	// ErrNoSuchElement is returned by Next or Prev if there is no element in that direction.
+	ErrNoSuchElement = errors.New("container: no such element")
+	// ErrIllegalState is returned by Set or Remove if neither Next nor Prev has been called
+	// since the last Remove, InsertBefore or InsertAfter.
+	ErrIllegalState = errors.New("container: no element returned by Next or Prev")
+	// ErrConcurrentModification is returned if the list is structurally modified
+	// other than through the iterator itself.
+	ErrConcurrentModification = errors.New("container: list is modified outside of the iterator")
+)
+
+// Stack is a Stack interface of interface{} elements, which is LIFO (last-in-first-out).
+type Stack = StackOf[interface{}]
+
+// StackOf is a Stack interface of T elements, which is LIFO (last-in-first-out).
+type StackOf[T any] interface {
 	// Len returns the number of elements in the collection.
 	Len() int
 	// IsEmpty returns true if this container contains no elements.
@@ -9,15 +28,19 @@ type Stack interface {
 	// Clear initializes or clears all of the elements from this container.
 	Clear()
 	// Push pushes an element into this Stack.
-	Push(interface{})
-	// Pop pops the element on the top of this Stack.
-	Pop() interface{}
-	// Peek retrieves, but does not remove, the element on the top of this Stack, or return nil if this Stack is empty.
-	Peek() interface{}
+	Push(T)
+	// Pop pops the element on the top of this Stack, or return the zero value if this Stack is empty.
+	Pop() T
+	// Peek retrieves, but does not remove, the element on the top of this Stack,
+	// or return the zero value (nil for interface{}) if this Stack is empty.
+	Peek() T
 }
 
-// Queue is a type of Queue, which is FIFO(first-in-first-out).
-type Queue interface {
+// Queue is a type of Queue of interface{} elements, which is FIFO(first-in-first-out).
+type Queue = QueueOf[interface{}]
+
+// QueueOf is a type of Queue of T elements, which is FIFO(first-in-first-out).
+type QueueOf[T any] interface {
 	// Len returns the number of elements in the collection.
 	Len() int
 	// IsEmpty returns true if this container contains no elements.
@@ -25,19 +48,79 @@ type Queue interface {
 	// Clear initializes or clears all of the elements from this container.
 	Clear()
 	// Add inserts an element into the tail of this Queue.
-	Add(interface{})
-	// Peek retrieves, but does not remove, the head of this Queue, or return nil if this Queue is empty.
-	Peek() interface{}
-	// Poll retrieves and removes the head of the this Queue, or return nil if this Queue is empty.
-	Poll() interface{}
+	Add(T)
+	// Peek retrieves, but does not remove, the head of this Queue,
+	// or return the zero value (nil for interface{}) if this Queue is empty.
+	Peek() T
+	// Poll retrieves and removes the head of the this Queue,
+	// or return the zero value (nil for interface{}) if this Queue is empty.
+	Poll() T
 	// Remove a single instance of the specified element from this queue, if it is present.
-	Remove(val interface{})
+	Remove(val T)
 	// Contains returns true if this queue contains the specified element.
-	Contains(val interface{}) bool
+	Contains(val T) bool
+}
+
+// ExtendedQueue is a Queue of interface{} elements, which can be inspected without draining.
+type ExtendedQueue = ExtendedQueueOf[interface{}]
+
+// ExtendedQueueOf is a Queue of T elements, which can be inspected without draining.
+// It is separated from QueueOf, so the implementations of QueueOf are not broken.
+type ExtendedQueueOf[T any] interface {
+	QueueOf[T]
+	// AddAll inserts the elements into the tail of this Queue in order.
+	AddAll(vals ...T)
+	// Get returns the element at the specified position from the head. The index must be in the range of [0, size).
+	Get(index int) (T, error)
+	// RemoveIf removes all of the elements of this Queue that satisfy the given predicate.
+	// It returns the number of the removed elements.
+	RemoveIf(pred func(T) bool) int
+	// Iterator returns an iterator over the elements in this Queue from head to tail.
+	Iterator(f func(T) bool)
+	// ReverseIterator returns an iterator over the elements in this Queue from tail to head as Iterator.
+	ReverseIterator(f func(T) bool)
+	// Values returns a copy of all the elements from head to tail.
+	Values() []T
 }
 
-// List is a type of list, both ArrayList and LinkedList implement this interface.
-type List interface {
+// Deque is a double-ended queue of interface{} elements.
+type Deque = DequeOf[interface{}]
+
+// DequeOf is a double-ended queue of T elements, which supports insertion and removal at both ends.
+type DequeOf[T any] interface {
+	// Len returns the number of elements in the collection.
+	Len() int
+	// IsEmpty returns true if this container contains no elements.
+	IsEmpty() bool
+	// Clear initializes or clears all of the elements from this container.
+	Clear()
+	// PushFront inserts an element at the front of this Deque.
+	PushFront(v T)
+	// PushBack inserts an element at the back of this Deque.
+	PushBack(v T)
+	// PopFront retrieves and removes the front element of this Deque,
+	// or return the zero value (nil for interface{}) if this Deque is empty.
+	PopFront() T
+	// PopBack retrieves and removes the back element of this Deque,
+	// or return the zero value (nil for interface{}) if this Deque is empty.
+	PopBack() T
+	// PeekFront retrieves, but does not remove, the front element of this Deque,
+	// or return the zero value (nil for interface{}) if this Deque is empty.
+	PeekFront() T
+	// PeekBack retrieves, but does not remove, the back element of this Deque,
+	// or return the zero value (nil for interface{}) if this Deque is empty.
+	PeekBack() T
+	// At returns the element at the specified position from the front. The index must be in the range of [0, size).
+	At(index int) (T, error)
+	// Set replaces the element at the specified position from the front. The index must be in the range of [0, size).
+	Set(index int, v T) error
+}
+
+// List is a type of list of interface{} elements, both ArrayList and LinkedList implement this interface.
+type List = ListOf[interface{}]
+
+// ListOf is a type of list of T elements, both ArrayList and LinkedList implement this interface.
+type ListOf[T any] interface {
 	// Len returns the number of elements in the collection.
 	Len() int
 	// IsEmpty returns true if this container contains no elements.
@@ -45,52 +128,138 @@ type List interface {
 	// Clear initializes or clears all of the elements from this container.
 	Clear()
 	// Push appends the specified elements to the end of this list.
-	Push(vals interface{})
+	Push(vals T)
 	// PushFront inserts a new element e with value v at the front of list l
-	PushFront(v interface{})
+	PushFront(v T)
 	// PushBack inserts a new element e with value v at the back of list l.
-	PushBack(v interface{})
+	PushBack(v T)
 	// Add inserts the specified element at the specified position in this list.
-	Add(index int, val interface{}) error
+	Add(index int, val T) error
 
 	// Poll return the front element value and then remove from list
-	Poll() interface{}
+	Poll() T
 	// PollFront return the front element value and then remove from list
-	PollFront() interface{}
+	PollFront() T
 	// PollBack return the back element value and then remove from list
-	PollBack() interface{}
+	PollBack() T
 	// Remove removes the element at the specified position in this list.
 	// It returns an error if the index is out of range.
-	Remove(index int) (interface{}, error)
+	Remove(index int) (T, error)
 	// RemoveValue removes the first occurrence of the specified element from this list, if it is present.
 	// It returns false if the target value isn't present, otherwise returns true.
-	RemoveValue(val interface{}) bool
+	RemoveValue(val T) bool
 
 	// Get returns the element at the specified position in this list. The index must be in the range of [0, size).
-	Get(index int) (interface{}, error)
+	Get(index int) (T, error)
 	// Peek return the front element value
-	Peek() interface{}
+	Peek() T
 	// PeekFront return the front element value
-	PeekFront() interface{}
+	PeekFront() T
 	// PeekBack return the back element value
-	PeekBack() interface{}
+	PeekBack() T
 
 	// Iterator returns an iterator over the elements in this list in proper sequence.
-	Iterator(f func(interface{}) bool)
+	Iterator(f func(T) bool)
 	// ReverseIterator returns an iterator over the elements in this list in reverse sequence as Iterator.
-	ReverseIterator(f func(interface{}) bool)
+	ReverseIterator(f func(T) bool)
 
 	// Contains returns true if this list contains the specified element.
-	Contains(val interface{}) bool
+	Contains(val T) bool
 	// Sort sorts the element using default options below.
 	// It sorts the elements into ascending sequence according to their natural ordering.
 	Sort(reverse ...bool)
 	// Values get a copy of all the values in the list
-	Values() []interface{}
+	Values() []T
 }
 
-// LinkedMap is a type of linked map, and LinkedMap implements this interface.
-type LinkedMap interface {
+// ExtendedList is a List of interface{} elements with the bulk operations and the ListIterator.
+type ExtendedList = ExtendedListOf[interface{}]
+
+// ExtendedListOf is a List of T elements with the bulk operations and the ListIterator, which modifies it in place.
+// It is separated from ListOf, so the implementations of ListOf are not broken.
+type ExtendedListOf[T any] interface {
+	ListOf[T]
+	// ListIterator returns a bidirectional cursor over the elements in this list, starting from the front.
+	ListIterator() ListIteratorOf[T]
+	// ListIteratorAt returns a bidirectional cursor over the elements in this list, starting from the index.
+	// The index must be in the range of [0, size], the first Next returns the element at the index.
+	ListIteratorAt(index int) (ListIteratorOf[T], error)
+
+	// Set replaces the element at the specified position in this list with val, it returns the old element.
+	// It returns an error if the index is out of range.
+	Set(index int, val T) (T, error)
+	// IndexOf returns the index of the first occurrence of the specified element, or -1 if it isn't present.
+	IndexOf(val T) int
+	// LastIndexOf returns the index of the last occurrence of the specified element, or -1 if it isn't present.
+	LastIndexOf(val T) int
+	// SubList returns a new list with a copy of the elements in the range of [from, to).
+	// It returns an error if the range is out of range.
+	SubList(from, to int) (ExtendedListOf[T], error)
+	// AddAll inserts the specified elements at the specified position in this list in order.
+	// It returns an error if the index is out of range.
+	AddAll(index int, vals ...T) error
+	// RemoveIf removes all of the elements that satisfy the given predicate.
+	// It returns the number of the removed elements.
+	RemoveIf(pred func(T) bool) int
+	// RetainAll retains only the elements which are equal to one of vals.
+	// It returns the number of the removed elements.
+	RetainAll(vals ...T) int
+	// ReplaceAll replaces each element with the result of fn applied to it.
+	ReplaceAll(fn func(T) T)
+	// Reverse reverses the order of the elements.
+	Reverse()
+	// Swap swaps the elements at the specified positions.
+	// It returns an error if any index is out of range.
+	Swap(i, j int) error
+	// Rotate rotates the elements by the specified distance,
+	// the element at index i moves to index (i + distance) mod size, distance may be negative.
+	Rotate(distance int)
+	// Clone returns a shallow copy of this list, the comparator is kept.
+	Clone() ExtendedListOf[T]
+	// Equal returns true if other has the same elements in the same order,
+	// the elements are compared by the comparator of this list.
+	Equal(other ListOf[T]) bool
+}
+
+// ListIterator is a bidirectional cursor over a list of interface{} elements.
+type ListIterator = ListIteratorOf[interface{}]
+
+// ListIteratorOf is a bidirectional cursor over a list of T elements, which can modify the list in place.
+// The cursor lies between two elements, Next returns the element after it and Prev returns the element before it.
+// It is fail-fast, every method except HasNext, HasPrev, NextIndex and PrevIndex returns
+// ErrConcurrentModification once the list is structurally modified other than through the iterator.
+type ListIteratorOf[T any] interface {
+	// HasNext returns true if there is an element after the cursor.
+	HasNext() bool
+	// Next returns the element after the cursor and moves the cursor forward.
+	// It returns ErrNoSuchElement if the cursor is at the end.
+	Next() (T, error)
+	// HasPrev returns true if there is an element before the cursor.
+	HasPrev() bool
+	// Prev returns the element before the cursor and moves the cursor backward.
+	// It returns ErrNoSuchElement if the cursor is at the front.
+	Prev() (T, error)
+	// NextIndex returns the index of the element that would be returned by Next, or the length at the end.
+	NextIndex() int
+	// PrevIndex returns the index of the element that would be returned by Prev, or -1 at the front.
+	PrevIndex() int
+	// Set replaces the element last returned by Next or Prev with v.
+	// It returns ErrIllegalState if there is no such element.
+	Set(v T) error
+	// Remove removes the element last returned by Next or Prev.
+	// It returns ErrIllegalState if there is no such element.
+	Remove() error
+	// InsertBefore inserts v before the cursor, a following Next is not affected and Prev returns v.
+	InsertBefore(v T) error
+	// InsertAfter inserts v after the cursor, a following Prev is not affected and Next returns v.
+	InsertAfter(v T) error
+}
+
+// LinkedMap is a type of linked map of interface{} keys and values, and LinkedMap implements this interface.
+type LinkedMap = LinkedMapOf[interface{}, interface{}]
+
+// LinkedMapOf is a type of linked map of K keys and V values, and LinkedMap implements this interface.
+type LinkedMapOf[K comparable, V any] interface {
 	// Cap returns the capacity of elements of list l.
 	Cap() int
 	// Len returns the number of elements in the collection.
@@ -102,52 +271,54 @@ type LinkedMap interface {
 	// Push associates the specified value with the specified key in this map.
 	// If the map previously contained a mapping for the key,
 	// the old value is replaced by the specified value. and then move the item to the back of the list.
-	// If over the cap, it will remove the back item then push new item to back
-	// It returns the previous value associated with the specified key, or nil if there was no mapping for the key.
-	// A nil return can also indicate that the map previously associated nil with the specified key.
-	Push(k, v interface{}) interface{}
+	// If over the cap, it will remove the front item then push new item to back
+	// It returns the previous value associated with the specified key, or the zero value if there was no mapping for the key.
+	// A zero value return can also indicate that the map previously associated the zero value with the specified key.
+	Push(k K, v V) V
 	// PushFront associates the specified value with the specified key in this map.
 	// If the map previously contained a mapping for the key,
 	// the old value is replaced by the specified value. and then move the item to the front of the list.
 	// If over the cap, it will remove the back item then push new item to front
-	// It returns the previous value associated with the specified key, or nil if there was no mapping for the key.
-	// A nil return can also indicate that the map previously associated nil with the specified key.
-	PushFront(k, v interface{}) interface{}
+	// It returns the previous value associated with the specified key, or the zero value if there was no mapping for the key.
+	// A zero value return can also indicate that the map previously associated the zero value with the specified key.
+	PushFront(k K, v V) V
 	// PushBack associates the specified value with the specified key in this map.
 	// If the map previously contained a mapping for the key,
 	// the old value is replaced by the specified value. and then move the item to the back of the list.
-	// If over the cap, it will remove the back item then push new item to back
-	PushBack(k, v interface{}) interface{}
+	// If over the cap, it will remove the front item then push new item to back
+	PushBack(k K, v V) V
 
 	// Poll removes the first element from this map, which is the head of the list.
-	// It returns the (key, value, true) if the map isn't empty, or (nil, nil, false) if the map is empty.
-	Poll() (interface{}, interface{}, bool)
+	// It returns the (key, value, true) if the map isn't empty, or (zero, zero, false) if the map is empty.
+	Poll() (K, V, bool)
 	// PollFront return the front element value and then remove from list
-	PollFront() (k interface{}, v interface{}, exist bool)
+	PollFront() (k K, v V, exist bool)
 	// PollBack removes the last element from this map, which is the tail of the list.
-	// It returns the (key, value, true) if the map isn't empty, or (nil, nil, false) if the map is empty.
-	PollBack() (interface{}, interface{}, bool)
+	// It returns the (key, value, true) if the map isn't empty, or (zero, zero, false) if the map is empty.
+	PollBack() (K, V, bool)
 	// Remove removes the mapping for a key from this map if it is present.
 	// It returns the value to which this map previously associated the key, and true,
-	// or nil and false if the map contained no mapping for the key.
-	Remove(k interface{}) (interface{}, bool)
+	// or the zero value and false if the map contained no mapping for the key.
+	Remove(k K) (V, bool)
 
-	// Get returns the value to which the specified key is mapped, or nil if this map contains no mapping for the key.
-	Get(k interface{}, defaultValue ...interface{}) interface{}
+	// Get returns the value to which the specified key is mapped,
+	// or the default value (the zero value if not given) if this map contains no mapping for the key.
+	// The accessed item is moved to the back of the list.
+	Get(k K, defaultValue ...V) V
 	// Peek return the front element value
-	Peek() (k, v interface{}, exist bool)
+	Peek() (k K, v V, exist bool)
 	// PeekFront return the front element value
-	PeekFront() (k, v interface{}, exist bool)
+	PeekFront() (k K, v V, exist bool)
 	// PeekBack return the back element value
-	PeekBack() (k, v interface{}, exist bool)
+	PeekBack() (k K, v V, exist bool)
 
 	// Iterator returns an iterator over the elements in this map in proper sequence.
-	Iterator(cb func(k interface{}, v interface{}) bool)
+	Iterator(cb func(k K, v V) bool)
 	// ReverseIterator returns an iterator over the elements in this map in reverse sequence as Iterator.
-	ReverseIterator(cb func(k interface{}, v interface{}) bool)
+	ReverseIterator(cb func(k K, v V) bool)
 
 	// Contains returns true if this map contains a mapping for the specified key.
-	Contains(k interface{}) bool
+	Contains(k K) bool
 	// ContainsValue returns true if this map maps one or more keys to the specified value.
-	ContainsValue(v interface{}) bool
+	ContainsValue(v V) bool
 }
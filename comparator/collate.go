@@ -0,0 +1,148 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package comparator
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// The collators for displaying sorted strings to end users.
+var (
+	// NaturalOrder compares strings in natural order, "file2" < "file10".
+	NaturalOrder Comparator = Collator{Natural: true}
+	// CaseInsensitiveOrder compares strings with Unicode case folding, "Go" == "go".
+	CaseInsensitiveOrder Comparator = Collator{IgnoreCase: true}
+	// UnicodeOrder compares strings after canonical decomposition, "Éclair" < "zebra".
+	UnicodeOrder Comparator = Collator{Normalize: true}
+)
+
+// Collator is a Comparator for strings, it compares in up to three levels,
+// a later level is used only if the strings are equal in the earlier ones:
+//   - primary: the letters, case folded if IgnoreCase or Normalize, without accents if Normalize.
+//   - secondary: the accents, only if Normalize.
+//   - tertiary: the case and the leading zeros of numbers, byte-wise, unless IgnoreCase.
+//
+// It panics if the arguments are not strings.
+type Collator struct {
+	// Natural compares sequences of ASCII digits by their numeric value.
+	Natural bool
+	// IgnoreCase compares with Unicode case folding, strings differ only in case are equal.
+	IgnoreCase bool
+	// Normalize compares the canonical decomposition (NFD) of the strings,
+	// so the canonically equivalent strings (NFC and NFD forms) are equal,
+	// and the accented letters are ordered next to their base letters.
+	Normalize bool
+}
+
+// Compare implement Comparator.
+func (sf Collator) Compare(v1, v2 interface{}) int {
+	s1, s2 := v1.(string), v2.(string)
+	if sf.Normalize {
+		s1, s2 = norm.NFD.String(s1), norm.NFD.String(s2)
+	}
+
+	if ret := sf.compare(s1, s2, sf.IgnoreCase || sf.Normalize, sf.Normalize); ret != 0 {
+		return ret
+	}
+	if sf.Normalize {
+		if ret := sf.compare(s1, s2, true, false); ret != 0 {
+			return ret
+		}
+	}
+	if sf.IgnoreCase {
+		return 0
+	}
+	return strings.Compare(s1, s2)
+}
+
+// compare compares s1 and s2 rune by rune,
+// folds the case if fold is true, skips the combining marks if skipMark is true.
+func (sf Collator) compare(s1, s2 string, fold, skipMark bool) int {
+	for {
+		s1, s2 = skipMarks(s1, skipMark), skipMarks(s2, skipMark)
+		if s1 == "" || s2 == "" {
+			return len(s1) - len(s2)
+		}
+
+		if sf.Natural && isDigit(s1[0]) && isDigit(s2[0]) {
+			var n1, n2 string
+
+			n1, s1 = splitDigits(s1)
+			n2, s2 = splitDigits(s2)
+			if ret := compareNumber(n1, n2); ret != 0 {
+				return ret
+			}
+			continue
+		}
+
+		r1, size1 := utf8.DecodeRuneInString(s1)
+		r2, size2 := utf8.DecodeRuneInString(s2)
+		if fold {
+			r1, r2 = foldRune(r1), foldRune(r2)
+		}
+		if r1 != r2 {
+			return int(r1) - int(r2)
+		}
+		s1, s2 = s1[size1:], s2[size2:]
+	}
+}
+
+// skipMarks skips the leading combining marks of s if skip is true.
+func skipMarks(s string, skip bool) string {
+	for skip && s != "" {
+		r, size := utf8.DecodeRuneInString(s)
+		if !unicode.Is(unicode.Mn, r) {
+			break
+		}
+		s = s[size:]
+	}
+	return s
+}
+
+func isDigit(b byte) bool { return '0' <= b && b <= '9' }
+
+// splitDigits splits s into the leading ASCII digits and the rest.
+func splitDigits(s string) (digits, rest string) {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// compareNumber compares two sequences of ASCII digits by their numeric value.
+func compareNumber(n1, n2 string) int {
+	n1, n2 = strings.TrimLeft(n1, "0"), strings.TrimLeft(n2, "0")
+	if len(n1) != len(n2) {
+		return len(n1) - len(n2)
+	}
+	return strings.Compare(n1, n2)
+}
+
+// foldRune returns the lower case of r after mapping to upper case,
+// so all the runes of the same case folding, like 'K', 'k' and KELVIN SIGN, map to the same rune.
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'A' <= r && r <= 'Z' {
+			r += 'a' - 'A'
+		}
+		return r
+	}
+	return unicode.ToLower(unicode.ToUpper(r))
+}
@@ -0,0 +1,67 @@
+package comparator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNaturalOrder(t *testing.T) {
+	assert.True(t, NaturalOrder.Compare("file2", "file10") < 0)
+	assert.True(t, NaturalOrder.Compare("file10", "file2") > 0)
+	assert.True(t, NaturalOrder.Compare("file10", "file10a") < 0)
+	assert.True(t, NaturalOrder.Compare("file02", "file2") < 0)
+	assert.True(t, NaturalOrder.Compare("a1b2", "a1b10") < 0)
+	assert.True(t, NaturalOrder.Compare("10", "9") > 0)
+	assert.True(t, NaturalOrder.Compare("123456789012345678901234567890", "99") > 0)
+	assert.True(t, NaturalOrder.Compare("", "0") < 0)
+	assert.True(t, NaturalOrder.Compare("File", "file") < 0)
+	assert.Zero(t, NaturalOrder.Compare("file10", "file10"))
+
+	input := []interface{}{"file10", "file2", "file1", "File3", "file20", "file01"}
+	expected := []interface{}{"File3", "file01", "file1", "file2", "file10", "file20"}
+	Sort(input, NaturalOrder)
+	assert.Equal(t, expected, input)
+
+	assert.Panics(t, func() { NaturalOrder.Compare(1, "1") })
+}
+
+func TestCaseInsensitiveOrder(t *testing.T) {
+	assert.Zero(t, CaseInsensitiveOrder.Compare("Go", "go"))
+	assert.Zero(t, CaseInsensitiveOrder.Compare("ÉCLAIR", "éclair"))
+	assert.Zero(t, CaseInsensitiveOrder.Compare("K", "k")) // KELVIN SIGN
+	assert.True(t, CaseInsensitiveOrder.Compare("apple", "Banana") < 0)
+	assert.True(t, CaseInsensitiveOrder.Compare("Zoo", "apple") > 0)
+	assert.True(t, CaseInsensitiveOrder.Compare("app", "Apple") < 0)
+
+	input := []interface{}{"banana", "Cherry", "apple"}
+	expected := []interface{}{"apple", "banana", "Cherry"}
+	Sort(input, CaseInsensitiveOrder)
+	assert.Equal(t, expected, input)
+}
+
+func TestUnicodeOrder(t *testing.T) {
+	// NFC and NFD forms are equal
+	assert.Zero(t, UnicodeOrder.Compare("Éclair", "Éclair"))
+	assert.True(t, UnicodeOrder.Compare("Éclair", "zebra") < 0)
+	assert.True(t, UnicodeOrder.Compare("eclair", "Éclair") < 0)
+	assert.True(t, UnicodeOrder.Compare("Éclair", "eclairs") < 0)
+	assert.True(t, UnicodeOrder.Compare("Eclair", "eclair") < 0)
+	assert.True(t, UnicodeOrder.Compare("resume", "résumé") < 0)
+
+	input := []interface{}{"zebra", "Éclair", "apple", "eclair", "Zürich", "zucchini"}
+	expected := []interface{}{"apple", "eclair", "Éclair", "zebra", "zucchini", "Zürich"}
+	Sort(input, UnicodeOrder)
+	assert.Equal(t, expected, input)
+}
+
+func TestCollator(t *testing.T) {
+	c := Collator{Natural: true, IgnoreCase: true, Normalize: true}
+	assert.Zero(t, c.Compare("Photo 2.JPG", "photo 02.jpg"))
+	assert.True(t, c.Compare("Été 9", "ete 10") < 0)
+
+	input := []interface{}{"Track 10", "track 2", "Über 1", "uber 3", "Track 1"}
+	expected := []interface{}{"Track 1", "track 2", "Track 10", "Über 1", "uber 3"}
+	Sort(input, c)
+	assert.Equal(t, expected, input)
+}
@@ -118,3 +118,41 @@ func TestCompare(t *testing.T) {
 	assert.Panics(t, func() { Compare(time.Now(), struct{}{}) })
 	assert.Panics(t, func() { Compare(map[string]string{"a": "b"}, map[string]string{"a": "b"}) })
 }
+
+func TestFuncOf(t *testing.T) {
+	assert.Nil(t, FuncOf[int](nil))
+
+	byLen := CompareFunc[string](func(s1, s2 string) int { return len(s1) - len(s2) })
+	assert.Equal(t, -1, FuncOf[string](byLen)("a", "bb"))
+	// the elements of interface type are checked at the comparison.
+	assert.Equal(t, 1, FuncOf[interface{}](byLen)("aa", "b"))
+	assert.Equal(t, 1, FuncOf[int](CompareFunc[interface{}](Compare))(2, 1))
+
+	// a CompareFunc of other elements fails at once.
+	assert.Panics(t, func() { FuncOf[int](byLen) })
+}
+
+func TestNaturalOf(t *testing.T) {
+	type level int
+	type name string
+	now := time.Now()
+
+	assert.Equal(t, -1, NaturalOf[int]()(1, 2))
+	assert.Equal(t, 1, NaturalOf[float64]()(2.5, 1))
+	assert.Equal(t, 0, NaturalOf[string]()("a", "a"))
+	// the named types are compared by their underlying types, which Compare can't.
+	assert.Equal(t, -1, NaturalOf[level]()(1, 2))
+	assert.Equal(t, 1, NaturalOf[name]()("b", "a"))
+	assert.Equal(t, -1, NaturalOf[bool]()(false, true))
+	assert.Equal(t, -1, NaturalOf[time.Time]()(now, now.Add(time.Second)))
+	assert.Equal(t, 1, NaturalOf[interface{}]()(2, 1))
+	assert.Nil(t, NaturalOf[struct{}]())
+	assert.Nil(t, NaturalOf[[]int]())
+}
+
+func TestOrderOf(t *testing.T) {
+	byLen := CompareFunc[string](func(s1, s2 string) int { return len(s1) - len(s2) })
+	assert.Equal(t, 1, OrderOf(byLen)("aa", "b"))
+	assert.Equal(t, -1, OrderOf[string](nil)("aa", "b"))
+	assert.Panics(t, func() { OrderOf[struct{ v int }](nil) })
+}
@@ -0,0 +1,195 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package comparator
+
+import (
+	"bufio"
+	"encoding/gob"
+	"errors"
+	"io"
+	"os"
+	"sort"
+)
+
+// DefaultRunSize is the default number of elements held in memory for each sorted run.
+const DefaultRunSize = 1 << 16
+
+// Encoder writes the elements of a sorted run to the underlying writer.
+type Encoder interface {
+	Encode(v interface{}) error
+}
+
+// Decoder reads the elements of a sorted run from the underlying reader.
+// It returns io.EOF when there are no more elements.
+type Decoder interface {
+	Decode() (interface{}, error)
+}
+
+// Codec creates the Encoder and Decoder for the temporary files of ExternalSort.
+type Codec interface {
+	NewEncoder(w io.Writer) Encoder
+	NewDecoder(r io.Reader) Decoder
+}
+
+// GobCodec is a Codec use encoding/gob.
+// The concrete types of the elements should be registered with gob.Register,
+// except the basic types.
+type GobCodec struct{}
+
+// NewEncoder implement Codec.
+func (GobCodec) NewEncoder(w io.Writer) Encoder { return gobEncoder{gob.NewEncoder(w)} }
+
+// NewDecoder implement Codec.
+func (GobCodec) NewDecoder(r io.Reader) Decoder { return gobDecoder{gob.NewDecoder(r)} }
+
+type gobEncoder struct{ enc *gob.Encoder }
+
+func (sf gobEncoder) Encode(v interface{}) error { return sf.enc.Encode(&v) }
+
+type gobDecoder struct{ dec *gob.Decoder }
+
+func (sf gobDecoder) Decode() (interface{}, error) {
+	var v interface{}
+	err := sf.dec.Decode(&v)
+	return v, err
+}
+
+// ExternalSort sorts the elements which can't be held in memory at once.
+// The elements are split into sorted runs of RunSize,
+// the runs are spilled to temporary files through Codec, then merged back with MergeIterators.
+// The sort is stable.
+type ExternalSort struct {
+	// RunSize is the max number of elements held in memory for each run, default DefaultRunSize.
+	RunSize int
+	// Codec encodes and decodes the elements of the runs, default GobCodec.
+	Codec Codec
+	// TempDir is the directory of the temporary files, default os.TempDir.
+	TempDir string
+	// Cmp is the comparator, default Compare.
+	Cmp Comparator
+	// Reverse sorts into descending sequence if true.
+	Reverse bool
+}
+
+// Sort sorts the elements of src, and calls f for each element in sorted sequence
+// until f returns false.
+// The temporary files are removed before Sort returns.
+func (sf *ExternalSort) Sort(src Iterator, f func(interface{}) bool) (err error) {
+	runSize := sf.RunSize
+	if runSize <= 0 {
+		runSize = DefaultRunSize
+	}
+
+	var runs []*os.File
+	defer func() {
+		for _, r := range runs {
+			r.Close()           // nolint: errcheck
+			os.Remove(r.Name()) // nolint: errcheck
+		}
+	}()
+
+	buf := make([]interface{}, 0, runSize)
+	for {
+		buf = buf[:0]
+		for len(buf) < runSize {
+			v, ok := src.Next()
+			if !ok {
+				break
+			}
+			buf = append(buf, v)
+		}
+		sort.Stable(&Container{Items: buf, Cmp: sf.Cmp, Reverse: sf.Reverse})
+		if len(buf) < runSize {
+			break
+		}
+		r, err := sf.spill(buf)
+		if r != nil {
+			runs = append(runs, r)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// the last run stays in memory, and merges behind the spilled runs to keep stable.
+	iters := make([]Iterator, 0, len(runs)+1)
+	decoders := make([]*runIterator, 0, len(runs))
+	for _, r := range runs {
+		if _, err = r.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		it := &runIterator{dec: sf.codec().NewDecoder(bufio.NewReader(r))}
+		decoders = append(decoders, it)
+		iters = append(iters, it)
+	}
+	iters = append(iters, SliceIterator(buf))
+
+	it := MergeIterators(sf.Cmp, iters, sf.Reverse)
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		if f == nil || !f(v) {
+			break
+		}
+	}
+	for _, d := range decoders {
+		if d.err != nil {
+			return d.err
+		}
+	}
+	return nil
+}
+
+// spill writes a sorted run to a temporary file.
+func (sf *ExternalSort) spill(values []interface{}) (*os.File, error) {
+	fp, err := os.CreateTemp(sf.TempDir, "external-sort-")
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(fp)
+	enc := sf.codec().NewEncoder(w)
+	for _, v := range values {
+		if err = enc.Encode(v); err != nil {
+			return fp, err
+		}
+	}
+	return fp, w.Flush()
+}
+
+func (sf *ExternalSort) codec() Codec {
+	if sf.Codec != nil {
+		return sf.Codec
+	}
+	return GobCodec{}
+}
+
+// runIterator is an Iterator over a spilled run, it records the first decode error.
+type runIterator struct {
+	dec Decoder
+	err error
+}
+
+// Next implement Iterator.
+func (sf *runIterator) Next() (interface{}, bool) {
+	if sf.err != nil {
+		return nil, false
+	}
+	v, err := sf.dec.Decode()
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			sf.err = err
+		}
+		return nil, false
+	}
+	return v, true
+}
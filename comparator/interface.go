@@ -14,6 +14,12 @@
 
 package comparator
 
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+)
+
 // Comparator imposes a total ordering on some collection of objects, and it allows precise control over the sort order.
 type Comparator interface {
 	// Compare compares its two arguments for order.
@@ -22,3 +28,115 @@ type Comparator interface {
 	// is less than, equal to, or greater than the second.
 	Compare(v1, v2 interface{}) int
 }
+
+// CompareFunc is a generic comparison function of T elements, it implements Comparator.
+// It returns a negative integer, zero,
+// or a positive integer as the first argument
+// is less than, equal to, or greater than the second.
+type CompareFunc[T any] func(v1, v2 T) int
+
+// Compare implement Comparator, it panics if the arguments are not T.
+func (f CompareFunc[T]) Compare(v1, v2 interface{}) int { return f(v1.(T), v2.(T)) }
+
+// FuncOf returns the CompareFunc of T elements for the Comparator.
+// It returns c itself if c is a CompareFunc of T, or nil if c is nil.
+// The containers call it when they are created, so a CompareFunc of other elements,
+// such as WithCompareFunc[int] passed to NewOf[string], panics at construction.
+// A Comparator which is not a CompareFunc can't be checked, it panics at the first comparison if it doesn't fit T.
+func FuncOf[T any](c Comparator) CompareFunc[T] {
+	switch f := c.(type) {
+	case nil:
+		return nil
+	case CompareFunc[T]:
+		return f
+	}
+	if ct, t := reflect.TypeOf(c), reflect.TypeFor[T](); ct.Kind() == reflect.Func && t.Kind() != reflect.Interface &&
+		ct.NumIn() == 2 && !t.AssignableTo(ct.In(0)) {
+		panic(fmt.Sprintf("comparator: %s can't compare the elements of %s", ct, t))
+	}
+	return func(v1, v2 T) int { return c.Compare(v1, v2) }
+}
+
+// NaturalOf returns the CompareFunc of the natural ordering of T elements, or nil if T has no natural ordering.
+// The integers, floats and strings, including the named ones, are compared by cmp.Compare, false is less than true,
+// the types with a Compare(T) int method, such as time.Time, are compared by it.
+// The elements of interface type are compared by Compare, as their dynamic types are only known at runtime.
+func NaturalOf[T any]() CompareFunc[T] {
+	var zero T
+	switch any(zero).(type) {
+	case int:
+		return ordered[T, int]()
+	case int8:
+		return ordered[T, int8]()
+	case int16:
+		return ordered[T, int16]()
+	case int32:
+		return ordered[T, int32]()
+	case int64:
+		return ordered[T, int64]()
+	case uint:
+		return ordered[T, uint]()
+	case uint8:
+		return ordered[T, uint8]()
+	case uint16:
+		return ordered[T, uint16]()
+	case uint32:
+		return ordered[T, uint32]()
+	case uint64:
+		return ordered[T, uint64]()
+	case uintptr:
+		return ordered[T, uintptr]()
+	case float32:
+		return ordered[T, float32]()
+	case float64:
+		return ordered[T, float64]()
+	case string:
+		return ordered[T, string]()
+	case interface{ Compare(T) int }:
+		return func(v1, v2 T) int { return any(v1).(interface{ Compare(T) int }).Compare(v2) }
+	}
+
+	switch reflect.TypeFor[T]().Kind() { // nolint: exhaustive
+	case reflect.Interface:
+		return func(v1, v2 T) int { return Compare(v1, v2) }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(v1, v2 T) int { return cmp.Compare(reflect.ValueOf(v1).Int(), reflect.ValueOf(v2).Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(v1, v2 T) int { return cmp.Compare(reflect.ValueOf(v1).Uint(), reflect.ValueOf(v2).Uint()) }
+	case reflect.Float32, reflect.Float64:
+		return func(v1, v2 T) int { return cmp.Compare(reflect.ValueOf(v1).Float(), reflect.ValueOf(v2).Float()) }
+	case reflect.String:
+		return func(v1, v2 T) int { return cmp.Compare(reflect.ValueOf(v1).String(), reflect.ValueOf(v2).String()) }
+	case reflect.Bool:
+		return func(v1, v2 T) int {
+			b1, b2 := reflect.ValueOf(v1).Bool(), reflect.ValueOf(v2).Bool()
+			switch {
+			case b1 == b2:
+				return 0
+			case b2:
+				return -1
+			default:
+				return 1
+			}
+		}
+	}
+	return nil
+}
+
+// OrderOf returns c, or the natural ordering of T elements if c is nil, see NaturalOf.
+// It panics if c is nil and T has no natural ordering, such as a struct,
+// so the containers which need an ordering report a missing CompareFunc when they are created.
+func OrderOf[T any](c CompareFunc[T]) CompareFunc[T] {
+	if c != nil {
+		return c
+	}
+	if c = NaturalOf[T](); c == nil {
+		panic(fmt.Sprintf("comparator: %s has no natural ordering, a Comparator is needed", reflect.TypeFor[T]()))
+	}
+	return c
+}
+
+// ordered returns cmp.Compare of O as the CompareFunc of T elements, T must be O.
+func ordered[T any, O cmp.Ordered]() CompareFunc[T] {
+	return any(CompareFunc[O](cmp.Compare[O])).(CompareFunc[T])
+}
@@ -0,0 +1,122 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package comparator
+
+import (
+	"container/heap"
+)
+
+// Iterator is a pull iterator over a sequence of elements.
+type Iterator interface {
+	// Next returns the next element of the sequence,
+	// or (nil, false) if the sequence is exhausted.
+	Next() (interface{}, bool)
+}
+
+// IteratorFunc is an adapter to allow the use of ordinary functions as Iterator.
+type IteratorFunc func() (interface{}, bool)
+
+// Next implement Iterator.
+func (f IteratorFunc) Next() (interface{}, bool) { return f() }
+
+// SliceIterator returns an Iterator over the values in proper sequence.
+func SliceIterator(values []interface{}) Iterator {
+	i := 0
+	return IteratorFunc(func() (interface{}, bool) {
+		if i >= len(values) {
+			return nil, false
+		}
+		v := values[i]
+		i++
+		return v, true
+	})
+}
+
+// mergeItem is the head element of a merged source.
+type mergeItem struct {
+	value interface{}
+	src   int
+}
+
+// mergeComparator compares the head elements of the merged sources,
+// equal elements are ordered by the index of their source to keep the merge stable.
+type mergeComparator struct {
+	cmp     Comparator
+	reverse bool
+}
+
+// Compare implement Comparator.
+func (sf mergeComparator) Compare(v1, v2 interface{}) int {
+	m1, m2 := v1.(*mergeItem), v2.(*mergeItem)
+
+	var ret int
+	if sf.cmp != nil {
+		ret = sf.cmp.Compare(m1.value, m2.value)
+	} else {
+		ret = Compare(m1.value, m2.value)
+	}
+	if sf.reverse {
+		ret = -ret
+	}
+	if ret == 0 {
+		ret = m1.src - m2.src
+	}
+	return ret
+}
+
+// merger k-way merges sorted sources with the heap Container.
+type merger struct {
+	ctn   *Container
+	iters []Iterator
+}
+
+// Next implement Iterator.
+func (sf *merger) Next() (interface{}, bool) {
+	if sf.ctn.Len() == 0 {
+		return nil, false
+	}
+	item := sf.ctn.Items[0].(*mergeItem)
+	val := item.value
+	if v, ok := sf.iters[item.src].Next(); ok {
+		item.value = v
+		heap.Fix(sf.ctn, 0)
+	} else {
+		heap.Pop(sf.ctn)
+	}
+	return val, true
+}
+
+// MergeIterators k-way merges the sorted sources into a single sorted Iterator,
+// according to their natural ordering, or according to the provided comparator.
+// Each source must be sorted in ascending sequence, or descending sequence if reverse is true.
+// Equal elements keep the order of their sources, so the merge is stable.
+// It takes O(log k) time for each element, k is the number of sources.
+func MergeIterators(c Comparator, iters []Iterator, reverse ...bool) Iterator {
+	rev := false
+	if len(reverse) > 0 {
+		rev = reverse[0]
+	}
+	ctn := &Container{
+		Items: make([]interface{}, 0, len(iters)),
+		Cmp:   mergeComparator{c, rev},
+	}
+	for i, it := range iters {
+		if v, ok := it.Next(); ok {
+			ctn.Items = append(ctn.Items, &mergeItem{v, i})
+		}
+	}
+	heap.Init(ctn)
+	return &merger{ctn, iters}
+}
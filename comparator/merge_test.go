@@ -0,0 +1,171 @@
+package comparator
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func drain(it Iterator) []interface{} {
+	values := make([]interface{}, 0)
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestMergeIterators(t *testing.T) {
+	it := MergeIterators(nil, []Iterator{
+		SliceIterator([]interface{}{1, 4, 9}),
+		SliceIterator([]interface{}{}),
+		SliceIterator([]interface{}{2, 3, 10, 11}),
+		SliceIterator([]interface{}{0, 5}),
+	})
+	assert.Equal(t, []interface{}{0, 1, 2, 3, 4, 5, 9, 10, 11}, drain(it))
+
+	v, ok := it.Next()
+	assert.False(t, ok)
+	assert.Nil(t, v)
+
+	// no sources
+	assert.Empty(t, drain(MergeIterators(nil, nil)))
+}
+
+func TestMergeIteratorsReverse(t *testing.T) {
+	it := MergeIterators(nil, []Iterator{
+		SliceIterator([]interface{}{"tom", "john"}),
+		SliceIterator([]interface{}{"roy", "benjamin", "alice"}),
+	}, true)
+	assert.Equal(t, []interface{}{"tom", "roy", "john", "benjamin", "alice"}, drain(it))
+
+	it = MergeIterators(reverseInt{}, []Iterator{
+		SliceIterator([]interface{}{19, 6}),
+		SliceIterator([]interface{}{15, 9, 4}),
+	})
+	assert.Equal(t, []interface{}{19, 15, 9, 6, 4}, drain(it))
+}
+
+type pair struct {
+	key, src int
+}
+
+type pairComparator struct{}
+
+func (pairComparator) Compare(v1, v2 interface{}) int {
+	return v1.(pair).key - v2.(pair).key
+}
+
+func TestMergeIteratorsStable(t *testing.T) {
+	it := MergeIterators(pairComparator{}, []Iterator{
+		SliceIterator([]interface{}{pair{1, 0}, pair{2, 0}}),
+		SliceIterator([]interface{}{pair{1, 1}, pair{2, 1}}),
+		SliceIterator([]interface{}{pair{1, 2}}),
+	})
+	assert.Equal(t, []interface{}{
+		pair{1, 0}, pair{1, 1}, pair{1, 2}, pair{2, 0}, pair{2, 1},
+	}, drain(it))
+}
+
+func TestExternalSort(t *testing.T) {
+	input := make([]interface{}, 1000)
+	for i := range input {
+		input[i] = rand.Intn(500)
+	}
+	expected := append([]interface{}{}, input...)
+	Sort(expected, nil)
+
+	for _, runSize := range []int{1, 7, 100, 1000, 5000} {
+		dir, err := os.MkdirTemp("", "external-sort-test")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		es := &ExternalSort{RunSize: runSize, TempDir: dir}
+		got := make([]interface{}, 0, len(input))
+		err = es.Sort(SliceIterator(input), func(v interface{}) bool {
+			got = append(got, v)
+			return true
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+
+		// temporary files should be removed
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, files)
+	}
+
+	// reverse and stop early
+	es := &ExternalSort{RunSize: 3, Reverse: true}
+	got := make([]interface{}, 0)
+	err := es.Sort(SliceIterator([]interface{}{6, 4, 9, 19, 15, 1, 7}), func(v interface{}) bool {
+		got = append(got, v)
+		return len(got) < 4
+	})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{19, 15, 9, 7}, got)
+}
+
+func TestExternalSortStable(t *testing.T) {
+	input := make([]interface{}, 0, 100)
+	for i := 0; i < 100; i++ {
+		input = append(input, pair{rand.Intn(5), i})
+	}
+	es := &ExternalSort{RunSize: 8, Cmp: pairComparator{}, Codec: jsonPairCodec{}}
+	got := make([]interface{}, 0, len(input))
+	err := es.Sort(SliceIterator(input), func(v interface{}) bool {
+		got = append(got, v)
+		return true
+	})
+	require.NoError(t, err)
+	require.Len(t, got, len(input))
+	for i := 1; i < len(got); i++ {
+		p1, p2 := got[i-1].(pair), got[i].(pair)
+		require.True(t, p1.key < p2.key || (p1.key == p2.key && p1.src < p2.src))
+	}
+}
+
+func TestExternalSortCodecError(t *testing.T) {
+	es := &ExternalSort{RunSize: 2, Codec: errCodec{}}
+	err := es.Sort(SliceIterator([]interface{}{3, 2, 1, 0}), nil)
+	require.Error(t, err)
+}
+
+type jsonPair struct {
+	Key int `json:"key"`
+	Src int `json:"src"`
+}
+
+type jsonPairCodec struct{}
+
+func (jsonPairCodec) NewEncoder(w io.Writer) Encoder { return jsonPairEncoder{json.NewEncoder(w)} }
+func (jsonPairCodec) NewDecoder(r io.Reader) Decoder { return jsonPairDecoder{json.NewDecoder(r)} }
+
+type jsonPairEncoder struct{ enc *json.Encoder }
+
+func (sf jsonPairEncoder) Encode(v interface{}) error {
+	p := v.(pair)
+	return sf.enc.Encode(jsonPair{p.key, p.src})
+}
+
+type jsonPairDecoder struct{ dec *json.Decoder }
+
+func (sf jsonPairDecoder) Decode() (interface{}, error) {
+	var p jsonPair
+	if err := sf.dec.Decode(&p); err != nil {
+		return nil, err
+	}
+	return pair{p.Key, p.Src}, nil
+}
+
+type errCodec struct{}
+
+func (errCodec) NewEncoder(io.Writer) Encoder { return errCodec{} }
+func (errCodec) NewDecoder(io.Reader) Decoder { return errCodec{} }
+func (errCodec) Encode(interface{}) error     { return nil }
+func (errCodec) Decode() (interface{}, error) { return nil, errors.New("decode failure") }
@@ -0,0 +1,155 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package comparator
+
+import (
+	"runtime"
+	"sync"
+)
+
+// minParallelChunk is the minimum number of elements sorted by each worker,
+// smaller slices are not worth the goroutines.
+const minParallelChunk = 4096
+
+// insertionSortBlock is the size of blocks sorted by insertion sort before merging.
+const insertionSortBlock = 20
+
+// ParallelSort sorts values into ascending sequence according to their natural ordering,
+// or according to the provided comparator, with at most workers goroutines.
+// If workers <= 0, runtime.GOMAXPROCS(0) is used.
+// The values are partitioned into chunks which are sorted concurrently and then merged,
+// the result is identical to sort.Stable, so the comparator must be safe for concurrent use.
+// It needs O(n) extra memory.
+func ParallelSort(values []interface{}, c Comparator, workers int, reverse ...bool) {
+	rev := false
+	if len(reverse) > 0 {
+		rev = reverse[0]
+	}
+	if len(values) <= 1 {
+		return
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if n := (len(values) + minParallelChunk - 1) / minParallelChunk; n < workers {
+		workers = n
+	}
+
+	ctn := &Container{Cmp: c, Reverse: rev}
+	buf := make([]interface{}, len(values))
+	defer func() {
+		for i := range buf {
+			buf[i] = nil // should set nil for gc
+		}
+	}()
+
+	// partition and sort chunks concurrently.
+	chunk := (len(values) + workers - 1) / workers
+	bounds := make([]int, 0, workers+1)
+	for lo := 0; lo < len(values); lo += chunk {
+		bounds = append(bounds, lo)
+	}
+	bounds = append(bounds, len(values))
+
+	var wg sync.WaitGroup
+	for i := 0; i < len(bounds)-1; i++ {
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			ctn.mergeSort(values[lo:hi], buf[lo:hi])
+		}(bounds[i], bounds[i+1])
+	}
+	wg.Wait()
+
+	// merge adjacent chunks pairwise until only one is left.
+	src, dst := values, buf
+	for len(bounds) > 2 {
+		next := make([]int, 0, len(bounds)/2+1)
+		for i := 0; i < len(bounds)-1; i += 2 {
+			lo := bounds[i]
+			next = append(next, lo)
+			if i+2 >= len(bounds) { // odd one out, just copy it.
+				copy(dst[lo:], src[lo:bounds[i+1]])
+				continue
+			}
+			wg.Add(1)
+			go func(lo, mid, hi int) {
+				defer wg.Done()
+				ctn.merge(dst[lo:hi], src[lo:mid], src[mid:hi])
+			}(lo, bounds[i+1], bounds[i+2])
+		}
+		wg.Wait()
+		bounds = append(next, len(values))
+		src, dst = dst, src
+	}
+	if &src[0] == &buf[0] {
+		copy(values, buf)
+	}
+}
+
+// mergeSort stable sorts values with a bottom-up merge sort, buf must have the same length as values.
+func (sf *Container) mergeSort(values, buf []interface{}) {
+	n := len(values)
+	for lo := 0; lo < n; lo += insertionSortBlock {
+		hi := lo + insertionSortBlock
+		if hi > n {
+			hi = n
+		}
+		sf.insertionSort(values[lo:hi])
+	}
+
+	src, dst := values, buf
+	for width := insertionSortBlock; width < n; width *= 2 {
+		for lo := 0; lo < n; lo += 2 * width {
+			mid, hi := lo+width, lo+2*width
+			if mid > n {
+				mid = n
+			}
+			if hi > n {
+				hi = n
+			}
+			sf.merge(dst[lo:hi], src[lo:mid], src[mid:hi])
+		}
+		src, dst = dst, src
+	}
+	if n > 0 && &src[0] != &values[0] {
+		copy(values, src)
+	}
+}
+
+func (sf *Container) insertionSort(values []interface{}) {
+	for i := 1; i < len(values); i++ {
+		for j := i; j > 0 && sf.less(values[j], values[j-1]); j-- {
+			values[j], values[j-1] = values[j-1], values[j]
+		}
+	}
+}
+
+// merge merges the sorted a and b into dst, elements of a come first when equal.
+func (sf *Container) merge(dst, a, b []interface{}) {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if sf.less(b[j], a[i]) {
+			dst[k] = b[j]
+			j++
+		} else {
+			dst[k] = a[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], a[i:])
+	copy(dst[k:], b[j:])
+}
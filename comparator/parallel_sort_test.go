@@ -0,0 +1,42 @@
+package comparator
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelSort(t *testing.T) {
+	input1 := []interface{}{6, 4, 9, 19, 15}
+	expected1 := []interface{}{4, 6, 9, 15, 19}
+	ParallelSort(input1, nil, 4)
+	require.Equal(t, expected1, input1)
+
+	input2 := []interface{}{"benjamin", "alice", "john", "tom", "roy"}
+	expected2 := []interface{}{"tom", "roy", "john", "benjamin", "alice"}
+	ParallelSort(input2, reverseString{}, 0)
+	require.Equal(t, expected2, input2)
+
+	ParallelSort(nil, nil, 4)
+	ParallelSort([]interface{}{}, nil, 4)
+}
+
+func TestParallelSortStable(t *testing.T) {
+	for _, n := range []int{100, minParallelChunk*3 + 17, minParallelChunk * 8} {
+		for _, workers := range []int{0, 1, 3, 8} {
+			for _, reverse := range []bool{false, true} {
+				input := make([]interface{}, n)
+				for i := range input {
+					input[i] = pair{rand.Intn(100), i}
+				}
+				expected := append([]interface{}{}, input...)
+				sort.Stable(&Container{Items: expected, Cmp: pairComparator{}, Reverse: reverse})
+
+				ParallelSort(input, pairComparator{}, workers, reverse)
+				require.Equal(t, expected, input)
+			}
+		}
+	}
+}
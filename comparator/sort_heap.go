@@ -16,6 +16,7 @@ package comparator
 
 import (
 	"container/heap"
+	"slices"
 	"sort"
 )
 
@@ -44,14 +45,19 @@ func (sf *Container) Swap(i, j int) {
 
 // Less implement heap.Interface.
 func (sf *Container) Less(i, j int) bool {
+	return sf.less(sf.Items[i], sf.Items[j])
+}
+
+// less reports whether v1 should sort before v2.
+func (sf *Container) less(v1, v2 interface{}) bool {
 	if sf.Reverse {
-		i, j = j, i
+		v1, v2 = v2, v1
 	}
 
 	if sf.Cmp != nil {
-		return sf.Cmp.Compare(sf.Items[i], sf.Items[j]) < 0
+		return sf.Cmp.Compare(v1, v2) < 0
 	}
-	return Compare(sf.Items[i], sf.Items[j]) < 0
+	return Compare(v1, v2) < 0
 }
 
 // Push implement heap.Interface.
@@ -84,3 +90,15 @@ func Sort(values []interface{}, c Comparator, reverse ...bool) {
 	}
 	sort.Sort(&Container{Items: values, Cmp: c, Reverse: rev})
 }
+
+// SortOf sorts values of T elements into ascending sequence according to their natural ordering,
+// or according to the provided comparison function.
+// It panics if c is nil and T has no natural ordering, see OrderOf.
+func SortOf[T any](values []T, c CompareFunc[T], reverse ...bool) {
+	c = OrderOf(c)
+	if len(reverse) > 0 && reverse[0] {
+		slices.SortFunc(values, func(v1, v2 T) int { return c(v2, v1) })
+	} else {
+		slices.SortFunc(values, c)
+	}
+}
@@ -2,6 +2,7 @@ package comparator
 
 import (
 	"container/heap"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -18,6 +19,18 @@ func TestSort(t *testing.T) {
 	assertSort(t, input2, expected2, false, nil)
 }
 
+func TestSortOf(t *testing.T) {
+	type level int
+	values := []level{3, 1, 2}
+	SortOf(values, nil)
+	assert.Equal(t, []level{1, 2, 3}, values)
+	SortOf(values, nil, true)
+	assert.Equal(t, []level{3, 2, 1}, values)
+
+	// a struct has no natural ordering, it fails before sorting.
+	assert.Panics(t, func() { SortOf([]struct{ v int }{}, nil) })
+}
+
 func TestSortWithComparator(t *testing.T) {
 	input1 := []interface{}{6, 4, 9, 19, 15}
 	expected1 := []interface{}{19, 15, 9, 6, 4}
@@ -86,6 +99,42 @@ func (i reverseInt) Compare(v1, v2 interface{}) int {
 	return 0
 }
 
+func randomInts(n int) []interface{} {
+	values := make([]interface{}, n)
+	for i := range values {
+		values[i] = rand.Int()
+	}
+	return values
+}
+
+func benchmarkSort(b *testing.B, n int, sortFunc func(values []interface{})) {
+	input := randomInts(n)
+	values := make([]interface{}, n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		copy(values, input)
+		b.StartTimer()
+		sortFunc(values)
+	}
+}
+
+func BenchmarkSort100K(b *testing.B) {
+	benchmarkSort(b, 100000, func(values []interface{}) { Sort(values, nil) })
+}
+
+func BenchmarkParallelSort100K(b *testing.B) {
+	benchmarkSort(b, 100000, func(values []interface{}) { ParallelSort(values, nil, 0) })
+}
+
+func BenchmarkSort1M(b *testing.B) {
+	benchmarkSort(b, 1000000, func(values []interface{}) { Sort(values, nil) })
+}
+
+func BenchmarkParallelSort1M(b *testing.B) {
+	benchmarkSort(b, 1000000, func(values []interface{}) { ParallelSort(values, nil, 0) })
+}
+
 /***************************************heap*************************************/
 
 func TestHeap(t *testing.T) {
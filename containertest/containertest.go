@@ -0,0 +1,123 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package containertest implements the conformance test suites of the container interfaces,
+// so every implementation of container.List, container.Queue, container.Stack and container.LinkedMap
+// is verified the same way. TestQueue verifies the FIFO queues, TestPriorityQueue verifies the queues
+// which yield the elements in priority order.
+//
+// Every suite runs a table of scenarios and then a randomized model test,
+// which applies random operations to the implementation and to a reference slice or map,
+// and compares them after every operation. The model test uses DefaultSeed by default, so every run
+// takes the same random path, a different seed is given by WithSeed or the CONTAINERTEST_SEED environment variable,
+// like CONTAINERTEST_SEED=0 go test ./... for a time-based seed.
+//
+//	func TestConformance(t *testing.T) {
+//		containertest.TestList(t, func() container.ListOf[int] { return arraylist.NewOf[int]() })
+//	}
+package containertest
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// DefaultSeed is the default seed of the randomized model tests.
+const DefaultSeed = 1
+
+// SeedEnv is the environment variable of the seed of the randomized model tests, 0 means a time-based seed.
+const SeedEnv = "CONTAINERTEST_SEED"
+
+// Option is the option of the conformance suites.
+type Option func(*config)
+
+type config struct {
+	seed  int64
+	steps int
+	// err is the error of parsing SeedEnv, the model test fails with it.
+	err error
+}
+
+// WithSeed with the seed of the randomized model test, 0 means a time-based seed,
+// default is the CONTAINERTEST_SEED environment variable, or DefaultSeed if it is not set.
+// The seed is logged, so a failure can be reproduced with it.
+func WithSeed(seed int64) Option {
+	return func(c *config) {
+		c.seed = seed
+		c.err = nil
+	}
+}
+
+// WithSteps with the number of the random operations of the randomized model test, default 1000.
+func WithSteps(steps int) Option {
+	return func(c *config) {
+		if steps > 0 {
+			c.steps = steps
+		}
+	}
+}
+
+func newConfig(opts []Option) config {
+	c := config{
+		seed:  DefaultSeed,
+		steps: 1000,
+	}
+	if s, ok := os.LookupEnv(SeedEnv); ok {
+		seed, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			c.err = fmt.Errorf("containertest: invalid %s %q: %w", SeedEnv, s, err)
+		}
+		c.seed = seed
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.seed == 0 {
+		c.seed = time.Now().UnixNano()
+	}
+	return c
+}
+
+// rand returns the random source of the model test, and logs the seed.
+func (c config) rand(t *testing.T) *rand.Rand {
+	t.Helper()
+	if c.err != nil {
+		t.Fatal(c.err)
+	}
+	t.Logf("seed: %d", c.seed)
+	return rand.New(rand.NewSource(c.seed)) // nolint: gosec
+}
+
+// values collects the elements visited by the iterator until f returns false.
+func values[T any](iterator func(f func(T) bool)) []T {
+	vals := []T{}
+	iterator(func(v T) bool {
+		vals = append(vals, v)
+		return true
+	})
+	return vals
+}
+
+// reversed returns a reversed copy of vals.
+func reversed[T any](vals []T) []T {
+	r := make([]T, 0, len(vals))
+	for i := len(vals) - 1; i >= 0; i-- {
+		r = append(r, vals[i])
+	}
+	return r
+}
@@ -0,0 +1,314 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containertest
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+)
+
+// entry is a key-value pair of a LinkedMap.
+type entry struct {
+	k, v int
+}
+
+// TestLinkedMap runs the conformance suite of container.LinkedMap against the maps created by newMap,
+// newMap must return an empty map with the capacity, 0 means unbounded.
+func TestLinkedMap(t *testing.T, newMap func(capacity int) container.LinkedMapOf[int, int], opts ...Option) {
+	c := newConfig(opts)
+	for _, tt := range []struct {
+		name string
+		test func(t *testing.T, newMap func(capacity int) container.LinkedMapOf[int, int])
+	}{
+		{"Empty", testLinkedMapEmpty},
+		{"Push", testLinkedMapPush},
+		{"Remove", testLinkedMapRemove},
+		{"Iterator", testLinkedMapIterator},
+		{"Capacity", testLinkedMapCapacity},
+	} {
+		t.Run(tt.name, func(t *testing.T) { tt.test(t, newMap) })
+	}
+	t.Run("Model", func(t *testing.T) {
+		for _, capacity := range []int{0, 5} {
+			t.Run(fmt.Sprintf("Cap%d", capacity), func(t *testing.T) { testLinkedMapModel(t, c, newMap, capacity) })
+		}
+	})
+}
+
+func testLinkedMapEmpty(t *testing.T, newMap func(capacity int) container.LinkedMapOf[int, int]) {
+	m := newMap(0)
+	assert.Zero(t, m.Cap())
+	assert.Zero(t, m.Len())
+	assert.True(t, m.IsEmpty())
+	for _, f := range []func() (int, int, bool){m.Peek, m.PeekFront, m.PeekBack, m.Poll, m.PollFront, m.PollBack} {
+		k, v, ok := f()
+		assert.Zero(t, k)
+		assert.Zero(t, v)
+		assert.False(t, ok)
+	}
+	v, ok := m.Remove(1)
+	assert.Zero(t, v)
+	assert.False(t, ok)
+	assert.Zero(t, m.Get(1))
+	assert.Equal(t, 9, m.Get(1, 9))
+	assert.False(t, m.Contains(0))
+	assert.False(t, m.ContainsValue(0))
+	assert.Empty(t, entries(m.Iterator))
+	assert.Empty(t, entries(m.ReverseIterator))
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+}
+
+func testLinkedMapPush(t *testing.T, newMap func(capacity int) container.LinkedMapOf[int, int]) {
+	m := newMap(0)
+	assert.Zero(t, m.Push(2, 20))
+	assert.Zero(t, m.PushBack(3, 30))
+	assert.Zero(t, m.PushFront(1, 10))
+	assert.Equal(t, []entry{{1, 10}, {2, 20}, {3, 30}}, entries(m.Iterator))
+	assert.Equal(t, 3, m.Len())
+
+	assert.Equal(t, 10, m.PushBack(1, 11), "PushBack should return the old value")
+	assert.Equal(t, []entry{{2, 20}, {3, 30}, {1, 11}}, entries(m.Iterator), "PushBack should move the key to the back")
+	assert.Equal(t, 30, m.PushFront(3, 31), "PushFront should return the old value")
+	assert.Equal(t, []entry{{3, 31}, {2, 20}, {1, 11}}, entries(m.Iterator), "PushFront should move the key to the front")
+	assert.Equal(t, 3, m.Len())
+
+	k, v, ok := m.Peek()
+	assert.Equal(t, entry{3, 31}, entry{k, v})
+	assert.True(t, ok)
+	k, v, ok = m.PeekFront()
+	assert.Equal(t, entry{3, 31}, entry{k, v})
+	assert.True(t, ok)
+	k, v, ok = m.PeekBack()
+	assert.Equal(t, entry{1, 11}, entry{k, v})
+	assert.True(t, ok)
+
+	assert.Equal(t, 20, m.Get(2))
+	assert.Equal(t, 20, m.Get(2, 99))
+	assert.Equal(t, []entry{{3, 31}, {1, 11}, {2, 20}}, entries(m.Iterator), "Get should move the key to the back")
+	assert.Equal(t, 99, m.Get(4, 99))
+	assert.True(t, m.Contains(1))
+	assert.False(t, m.Contains(4))
+	assert.True(t, m.ContainsValue(31))
+	assert.False(t, m.ContainsValue(30))
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.False(t, m.Contains(1))
+	assert.Zero(t, m.Push(1, 12), "the map should be reusable after Clear")
+}
+
+func testLinkedMapRemove(t *testing.T, newMap func(capacity int) container.LinkedMapOf[int, int]) {
+	m := newMap(0)
+	for i := 1; i <= 5; i++ {
+		m.Push(i, i*10)
+	}
+	k, v, ok := m.Poll()
+	assert.Equal(t, entry{1, 10}, entry{k, v})
+	assert.True(t, ok)
+	k, v, ok = m.PollFront()
+	assert.Equal(t, entry{2, 20}, entry{k, v})
+	assert.True(t, ok)
+	k, v, ok = m.PollBack()
+	assert.Equal(t, entry{5, 50}, entry{k, v})
+	assert.True(t, ok)
+
+	v, ok = m.Remove(3)
+	assert.Equal(t, 30, v)
+	assert.True(t, ok)
+	v, ok = m.Remove(3)
+	assert.Zero(t, v)
+	assert.False(t, ok)
+	assert.False(t, m.Contains(3))
+	assert.Equal(t, []entry{{4, 40}}, entries(m.Iterator))
+}
+
+func testLinkedMapIterator(t *testing.T, newMap func(capacity int) container.LinkedMapOf[int, int]) {
+	m := newMap(0)
+	for i := 1; i <= 4; i++ {
+		m.Push(i, -i)
+	}
+	assert.Equal(t, []entry{{1, -1}, {2, -2}, {3, -3}, {4, -4}}, entries(m.Iterator))
+	assert.Equal(t, []entry{{4, -4}, {3, -3}, {2, -2}, {1, -1}}, entries(m.ReverseIterator))
+
+	var got []int
+	m.Iterator(func(k, _ int) bool {
+		got = append(got, k)
+		return k < 2
+	})
+	assert.Equal(t, []int{1, 2}, got, "Iterator should stop once cb returns false")
+	got = got[:0]
+	m.ReverseIterator(func(k, _ int) bool {
+		got = append(got, k)
+		return k > 3
+	})
+	assert.Equal(t, []int{4, 3}, got, "ReverseIterator should stop once cb returns false")
+}
+
+func testLinkedMapCapacity(t *testing.T, newMap func(capacity int) container.LinkedMapOf[int, int]) {
+	m := newMap(3)
+	assert.Equal(t, 3, m.Cap())
+	for i := 1; i <= 3; i++ {
+		m.PushBack(i, i)
+	}
+	m.PushBack(2, 20)
+	assert.Equal(t, []entry{{1, 1}, {3, 3}, {2, 20}}, entries(m.Iterator), "updating a key should not evict")
+
+	m.PushBack(4, 4)
+	assert.Equal(t, []entry{{3, 3}, {2, 20}, {4, 4}}, entries(m.Iterator), "PushBack should evict the front")
+	m.PushFront(5, 5)
+	assert.Equal(t, []entry{{5, 5}, {3, 3}, {2, 20}}, entries(m.Iterator), "PushFront should evict the back")
+	assert.Equal(t, 3, m.Len())
+	assert.False(t, m.Contains(1))
+	assert.False(t, m.Contains(4))
+}
+
+// testLinkedMapModel applies random operations to the map and a reference map with the ordered keys,
+// and compares them after every operation.
+func testLinkedMapModel(t *testing.T, c config, newMap func(capacity int) container.LinkedMapOf[int, int], capacity int) {
+	r := c.rand(t)
+	m := newMap(capacity)
+	var keys []int
+	model := make(map[int]int)
+
+	remove := func(k int) {
+		delete(model, k)
+		keys = slices.DeleteFunc(keys, func(key int) bool { return key == k })
+	}
+	full := func(k int) bool {
+		_, ok := model[k]
+		return !ok && capacity != 0 && len(keys) >= capacity
+	}
+	key := func() int { return r.Intn(12) }
+	value := func() int { return r.Intn(8) }
+
+	for step := 0; step < c.steps; step++ {
+		switch r.Intn(10) {
+		case 0, 1:
+			k, v := key(), value()
+			var old int
+			if r.Intn(2) == 0 {
+				old = m.PushBack(k, v)
+			} else {
+				old = m.Push(k, v)
+			}
+			require.Equal(t, model[k], old, "step %d: PushBack(%d)", step, k)
+			if full(k) {
+				remove(keys[0])
+			}
+			remove(k)
+			keys, model[k] = append(keys, k), v
+		case 2:
+			k, v := key(), value()
+			require.Equal(t, model[k], m.PushFront(k, v), "step %d: PushFront(%d)", step, k)
+			if full(k) {
+				remove(keys[len(keys)-1])
+			}
+			remove(k)
+			keys, model[k] = slices.Insert(keys, 0, k), v
+		case 3:
+			var want entry
+			exist := len(keys) > 0
+			if exist {
+				want = entry{keys[0], model[keys[0]]}
+				remove(want.k)
+			}
+			poll := m.PollFront
+			if r.Intn(2) == 0 {
+				poll = m.Poll
+			}
+			k, v, ok := poll()
+			require.Equal(t, want, entry{k, v}, "step %d: PollFront", step)
+			require.Equal(t, exist, ok, "step %d: PollFront", step)
+		case 4:
+			var want entry
+			exist := len(keys) > 0
+			if exist {
+				want = entry{keys[len(keys)-1], model[keys[len(keys)-1]]}
+				remove(want.k)
+			}
+			k, v, ok := m.PollBack()
+			require.Equal(t, want, entry{k, v}, "step %d: PollBack", step)
+			require.Equal(t, exist, ok, "step %d: PollBack", step)
+		case 5:
+			k := key()
+			want, exist := model[k]
+			v, ok := m.Remove(k)
+			require.Equal(t, want, v, "step %d: Remove(%d)", step, k)
+			require.Equal(t, exist, ok, "step %d: Remove(%d)", step, k)
+			remove(k)
+		case 6:
+			k := key()
+			want, exist := model[k]
+			if !exist {
+				want = -1
+			}
+			require.Equal(t, want, m.Get(k, -1), "step %d: Get(%d)", step, k)
+			if exist {
+				remove(k)
+				keys, model[k] = append(keys, k), want
+			}
+		case 7:
+			k, v := key(), value()
+			require.Equal(t, slices.Contains(keys, k), m.Contains(k), "step %d: Contains(%d)", step, k)
+			containsValue := false
+			for _, mv := range model {
+				containsValue = containsValue || mv == v
+			}
+			require.Equal(t, containsValue, m.ContainsValue(v), "step %d: ContainsValue(%d)", step, v)
+		default:
+			if r.Intn(10) == 0 {
+				m.Clear()
+				keys, model = keys[:0], make(map[int]int)
+			}
+		}
+
+		want := make([]entry, 0, len(keys))
+		for _, k := range keys {
+			want = append(want, entry{k, model[k]})
+		}
+		require.Equal(t, capacity, m.Cap(), "step %d: Cap", step)
+		require.Equal(t, len(keys), m.Len(), "step %d: Len", step)
+		require.Equal(t, len(keys) == 0, m.IsEmpty(), "step %d: IsEmpty", step)
+		require.Equal(t, want, entries(m.Iterator), "step %d: Iterator", step)
+		require.Equal(t, reversed(want), entries(m.ReverseIterator), "step %d: ReverseIterator", step)
+		var front, back entry
+		if len(want) > 0 {
+			front, back = want[0], want[len(want)-1]
+		}
+		k, v, ok := m.PeekFront()
+		require.Equal(t, front, entry{k, v}, "step %d: PeekFront", step)
+		require.Equal(t, len(want) > 0, ok, "step %d: PeekFront", step)
+		k, v, ok = m.PeekBack()
+		require.Equal(t, back, entry{k, v}, "step %d: PeekBack", step)
+		require.Equal(t, len(want) > 0, ok, "step %d: PeekBack", step)
+	}
+}
+
+// entries collects the key-value pairs visited by the iterator until cb returns false.
+func entries(iterator func(cb func(k, v int) bool)) []entry {
+	es := []entry{}
+	iterator(func(k, v int) bool {
+		es = append(es, entry{k, v})
+		return true
+	})
+	return es
+}
@@ -0,0 +1,626 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containertest
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+)
+
+// TestList runs the conformance suite of container.List against the lists created by newList,
+// newList must return an empty list with the natural ordering of int.
+// If the list implements container.ExtendedList, the bulk operations and the ListIterator are verified too.
+func TestList(t *testing.T, newList func() container.ListOf[int], opts ...Option) {
+	c := newConfig(opts)
+	newListOf := func(t *testing.T, vals ...int) container.ListOf[int] {
+		t.Helper()
+		l := newList()
+		for _, v := range vals {
+			l.PushBack(v)
+		}
+		return l
+	}
+
+	for _, tt := range []struct {
+		name string
+		test func(t *testing.T, newListOf func(t *testing.T, vals ...int) container.ListOf[int])
+	}{
+		{"Empty", testListEmpty},
+		{"Push", testListPush},
+		{"Remove", testListRemove},
+		{"Iterator", testListIterator},
+		{"Sort", testListSort},
+	} {
+		t.Run(tt.name, func(t *testing.T) { tt.test(t, newListOf) })
+	}
+	if _, ok := newList().(container.ExtendedListOf[int]); ok {
+		newExtendedListOf := func(t *testing.T, vals ...int) container.ExtendedListOf[int] {
+			t.Helper()
+			return newListOf(t, vals...).(container.ExtendedListOf[int])
+		}
+		for _, tt := range []struct {
+			name string
+			test func(t *testing.T, newListOf func(t *testing.T, vals ...int) container.ExtendedListOf[int])
+		}{
+			{"Bulk", testListBulk},
+			{"Rotate", testListRotate},
+			{"ListIterator", testListListIterator},
+		} {
+			t.Run(tt.name, func(t *testing.T) { tt.test(t, newExtendedListOf) })
+		}
+	}
+	t.Run("Model", func(t *testing.T) { testListModel(t, c, newList) })
+}
+
+func testListEmpty(t *testing.T, newListOf func(t *testing.T, vals ...int) container.ListOf[int]) {
+	l := newListOf(t)
+	assert.Zero(t, l.Len())
+	assert.True(t, l.IsEmpty())
+	assert.Empty(t, l.Values())
+	assert.Zero(t, l.Peek())
+	assert.Zero(t, l.PeekFront())
+	assert.Zero(t, l.PeekBack())
+	assert.Zero(t, l.Poll())
+	assert.Zero(t, l.PollFront())
+	assert.Zero(t, l.PollBack())
+	assert.False(t, l.Contains(0))
+	assert.False(t, l.RemoveValue(0))
+	_, err := l.Get(0)
+	assert.Error(t, err)
+	_, err = l.Remove(0)
+	assert.Error(t, err)
+	assert.Empty(t, values(l.Iterator))
+	assert.Empty(t, values(l.ReverseIterator))
+	l.Clear()
+	assert.True(t, l.IsEmpty())
+
+	if el, ok := l.(container.ExtendedListOf[int]); ok {
+		assert.Equal(t, -1, el.IndexOf(0))
+		assert.Equal(t, -1, el.LastIndexOf(0))
+		_, err = el.Set(0, 1)
+		assert.Error(t, err)
+		assert.True(t, el.Equal(newListOf(t)))
+		assert.False(t, el.ListIterator().HasNext())
+		assert.False(t, el.ListIterator().HasPrev())
+	}
+}
+
+func testListPush(t *testing.T, newListOf func(t *testing.T, vals ...int) container.ListOf[int]) {
+	l := newListOf(t)
+	l.Push(2)
+	l.PushBack(3)
+	l.PushFront(1)
+	require.NoError(t, l.Add(0, 0))
+	require.NoError(t, l.Add(l.Len(), 5))
+	require.NoError(t, l.Add(4, 4))
+	assert.Error(t, l.Add(-1, 9))
+	assert.Error(t, l.Add(l.Len()+1, 9))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, l.Values())
+	assert.Equal(t, 6, l.Len())
+	assert.False(t, l.IsEmpty())
+
+	for i := 0; i < l.Len(); i++ {
+		v, err := l.Get(i)
+		require.NoError(t, err)
+		assert.Equal(t, i, v)
+	}
+	_, err := l.Get(-1)
+	assert.Error(t, err)
+	_, err = l.Get(l.Len())
+	assert.Error(t, err)
+	assert.Equal(t, 0, l.Peek())
+	assert.Equal(t, 0, l.PeekFront())
+	assert.Equal(t, 5, l.PeekBack())
+
+	vals := l.Values()
+	vals[0] = 100
+	assert.Equal(t, 0, l.PeekFront(), "Values should be a copy")
+
+	l.Clear()
+	assert.True(t, l.IsEmpty())
+	assert.Empty(t, l.Values())
+}
+
+func testListRemove(t *testing.T, newListOf func(t *testing.T, vals ...int) container.ListOf[int]) {
+	l := newListOf(t, 1, 2, 3, 2, 4, 5)
+	assert.Equal(t, 1, l.Poll())
+	assert.Equal(t, 2, l.PollFront())
+	assert.Equal(t, 5, l.PollBack())
+	assert.Equal(t, []int{3, 2, 4}, l.Values())
+
+	v, err := l.Remove(1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+	_, err = l.Remove(-1)
+	assert.Error(t, err)
+	_, err = l.Remove(l.Len())
+	assert.Error(t, err)
+	assert.Equal(t, []int{3, 4}, l.Values())
+
+	l.PushBack(3)
+	assert.True(t, l.Contains(3))
+	assert.True(t, l.RemoveValue(3))
+	assert.Equal(t, []int{4, 3}, l.Values(), "RemoveValue should remove the first occurrence")
+	assert.False(t, l.RemoveValue(9))
+	assert.False(t, l.Contains(9))
+}
+
+func testListIterator(t *testing.T, newListOf func(t *testing.T, vals ...int) container.ListOf[int]) {
+	l := newListOf(t, 1, 2, 3, 4)
+	assert.Equal(t, []int{1, 2, 3, 4}, values(l.Iterator))
+	assert.Equal(t, []int{4, 3, 2, 1}, values(l.ReverseIterator))
+
+	var got []int
+	l.Iterator(func(v int) bool {
+		got = append(got, v)
+		return v < 2
+	})
+	assert.Equal(t, []int{1, 2}, got, "Iterator should stop once f returns false")
+	got = got[:0]
+	l.ReverseIterator(func(v int) bool {
+		got = append(got, v)
+		return v > 3
+	})
+	assert.Equal(t, []int{4, 3}, got, "ReverseIterator should stop once f returns false")
+}
+
+func testListSort(t *testing.T, newListOf func(t *testing.T, vals ...int) container.ListOf[int]) {
+	l := newListOf(t, 5, 1, 4, 1, 3, 2)
+	l.Sort()
+	assert.Equal(t, []int{1, 1, 2, 3, 4, 5}, l.Values())
+	l.Sort(true)
+	assert.Equal(t, []int{5, 4, 3, 2, 1, 1}, l.Values())
+
+	l = newListOf(t, 1)
+	l.Sort()
+	assert.Equal(t, []int{1}, l.Values())
+}
+
+func testListBulk(t *testing.T, newListOf func(t *testing.T, vals ...int) container.ExtendedListOf[int]) {
+	l := newListOf(t, 1, 2, 3, 2, 1)
+
+	// Set
+	old, err := l.Set(1, 9)
+	require.NoError(t, err)
+	assert.Equal(t, 2, old)
+	_, err = l.Set(5, 0)
+	assert.Error(t, err)
+	_, err = l.Set(-1, 0)
+	assert.Error(t, err)
+	assert.Equal(t, []int{1, 9, 3, 2, 1}, l.Values())
+
+	// IndexOf and LastIndexOf
+	assert.Equal(t, 0, l.IndexOf(1))
+	assert.Equal(t, 4, l.LastIndexOf(1))
+	assert.Equal(t, 3, l.IndexOf(2))
+	assert.Equal(t, 3, l.LastIndexOf(2))
+	assert.Equal(t, -1, l.IndexOf(7))
+	assert.Equal(t, -1, l.LastIndexOf(7))
+
+	// SubList
+	sub, err := l.SubList(1, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []int{9, 3, 2}, sub.Values())
+	sub.PushBack(8)
+	assert.Equal(t, 5, l.Len(), "SubList should be a copy")
+	sub, err = l.SubList(2, 2)
+	require.NoError(t, err)
+	assert.True(t, sub.IsEmpty())
+	_, err = l.SubList(3, 2)
+	assert.Error(t, err)
+	_, err = l.SubList(0, 6)
+	assert.Error(t, err)
+
+	// AddAll
+	require.NoError(t, l.AddAll(1, 7, 8))
+	require.NoError(t, l.AddAll(l.Len(), 6))
+	require.NoError(t, l.AddAll(0))
+	assert.Error(t, l.AddAll(100, 1))
+	assert.Equal(t, []int{1, 7, 8, 9, 3, 2, 1, 6}, l.Values())
+
+	// RemoveIf and RetainAll
+	assert.Equal(t, 2, l.RemoveIf(func(v int) bool { return v == 1 }))
+	assert.Zero(t, l.RemoveIf(func(v int) bool { return v > 100 }))
+	assert.Equal(t, []int{7, 8, 9, 3, 2, 6}, l.Values())
+	assert.Equal(t, 3, l.RetainAll(9, 8, 7, 0))
+	assert.Equal(t, []int{7, 8, 9}, l.Values())
+
+	// ReplaceAll
+	l.ReplaceAll(func(v int) int { return v * 10 })
+	assert.Equal(t, []int{70, 80, 90}, l.Values())
+
+	// Reverse and Swap
+	l.Reverse()
+	assert.Equal(t, []int{90, 80, 70}, l.Values())
+	require.NoError(t, l.Swap(0, 2))
+	require.NoError(t, l.Swap(1, 1))
+	assert.Error(t, l.Swap(0, 3))
+	assert.Equal(t, []int{70, 80, 90}, l.Values())
+
+	// Clone and Equal
+	c := l.Clone()
+	assert.True(t, l.Equal(c))
+	assert.True(t, c.Equal(l))
+	c.PushBack(100)
+	assert.False(t, l.Equal(c))
+	c.PollBack()
+	_, err = c.Set(0, 0)
+	require.NoError(t, err)
+	assert.False(t, l.Equal(c))
+	assert.False(t, l.Equal(nil))
+	assert.True(t, l.Equal(newListOf(t, 70, 80, 90)))
+}
+
+func testListRotate(t *testing.T, newListOf func(t *testing.T, vals ...int) container.ExtendedListOf[int]) {
+	for _, c := range []struct {
+		distance int
+		want     []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{1, []int{5, 1, 2, 3, 4}},
+		{2, []int{4, 5, 1, 2, 3}},
+		{4, []int{2, 3, 4, 5, 1}},
+		{5, []int{1, 2, 3, 4, 5}},
+		{7, []int{4, 5, 1, 2, 3}},
+		{-1, []int{2, 3, 4, 5, 1}},
+		{-8, []int{4, 5, 1, 2, 3}},
+	} {
+		l := newListOf(t, 1, 2, 3, 4, 5)
+		l.Rotate(c.distance)
+		assert.Equal(t, c.want, l.Values(), "distance: %d", c.distance)
+	}
+
+	l := newListOf(t)
+	l.Rotate(3)
+	l.Reverse()
+	assert.True(t, l.IsEmpty())
+}
+
+func testListListIterator(t *testing.T, newListOf func(t *testing.T, vals ...int) container.ExtendedListOf[int]) {
+	l := newListOf(t, 1, 2, 3)
+	it := l.ListIterator()
+	assert.ErrorIs(t, it.Set(0), container.ErrIllegalState)
+	assert.ErrorIs(t, it.Remove(), container.ErrIllegalState)
+	_, err := it.Prev()
+	assert.ErrorIs(t, err, container.ErrNoSuchElement)
+
+	var got []int
+	for it.HasNext() {
+		v, err := it.Next()
+		require.NoError(t, err)
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, got)
+	_, err = it.Next()
+	assert.ErrorIs(t, err, container.ErrNoSuchElement)
+	assert.Equal(t, 3, it.NextIndex())
+	assert.Equal(t, 2, it.PrevIndex())
+
+	// Set and ReplaceAll are not structural modifications
+	_, err = l.Set(0, 5)
+	require.NoError(t, err)
+	l.ReplaceAll(func(v int) int { return v + 1 })
+	v, err := it.Prev()
+	require.NoError(t, err)
+	assert.Equal(t, 4, v)
+
+	l.RemoveIf(func(v int) bool { return v == 3 })
+	_, err = it.Prev()
+	assert.ErrorIs(t, err, container.ErrConcurrentModification)
+	assert.ErrorIs(t, it.Set(0), container.ErrConcurrentModification)
+	assert.ErrorIs(t, it.Remove(), container.ErrConcurrentModification)
+	assert.ErrorIs(t, it.InsertBefore(0), container.ErrConcurrentModification)
+	assert.ErrorIs(t, it.InsertAfter(0), container.ErrConcurrentModification)
+
+	// the reordering operations are structural modifications
+	for name, reorder := range map[string]func(l container.ExtendedListOf[int]){
+		"Sort":    func(l container.ExtendedListOf[int]) { l.Sort(true) },
+		"Reverse": func(l container.ExtendedListOf[int]) { l.Reverse() },
+		"Rotate":  func(l container.ExtendedListOf[int]) { l.Rotate(1) },
+	} {
+		l := newListOf(t, 1, 2, 3)
+		it := l.ListIterator()
+		reorder(l)
+		_, err := it.Next()
+		assert.ErrorIs(t, err, container.ErrConcurrentModification, name)
+	}
+}
+
+// testListModel applies random operations to the list and a reference slice, and compares them after every operation.
+func testListModel(t *testing.T, c config, newList func() container.ListOf[int]) {
+	r := c.rand(t)
+	l := newList()
+	el, extended := l.(container.ExtendedListOf[int])
+	var model []int
+
+	// index returns a random index in the range of [-1, n+1], so the out of range indexes are covered.
+	index := func(n int) int { return r.Intn(n+3) - 1 }
+	value := func() int { return r.Intn(10) }
+
+	for step := 0; step < c.steps; step++ {
+		// the first 12 operations are the ones of container.List, the rest are the ones of container.ExtendedList.
+		ops := 12
+		if extended {
+			ops = 22
+		}
+		switch r.Intn(ops) {
+		case 0:
+			v := value()
+			l.PushBack(v)
+			model = append(model, v)
+		case 1:
+			v := value()
+			l.PushFront(v)
+			model = slices.Insert(model, 0, v)
+		case 2:
+			v := value()
+			l.Push(v)
+			model = append(model, v)
+		case 3:
+			i, v := index(len(model)), value()
+			err := l.Add(i, v)
+			if i < 0 || i > len(model) {
+				require.Error(t, err, "step %d: Add(%d)", step, i)
+			} else {
+				require.NoError(t, err, "step %d: Add(%d)", step, i)
+				model = slices.Insert(model, i, v)
+			}
+		case 4:
+			want := 0
+			if len(model) > 0 {
+				want, model = model[0], model[1:]
+			}
+			if r.Intn(2) == 0 {
+				require.Equal(t, want, l.Poll(), "step %d: Poll", step)
+			} else {
+				require.Equal(t, want, l.PollFront(), "step %d: PollFront", step)
+			}
+		case 5:
+			want := 0
+			if len(model) > 0 {
+				want, model = model[len(model)-1], model[:len(model)-1]
+			}
+			require.Equal(t, want, l.PollBack(), "step %d: PollBack", step)
+		case 6:
+			i := index(len(model))
+			v, err := l.Remove(i)
+			if i < 0 || i >= len(model) {
+				require.Error(t, err, "step %d: Remove(%d)", step, i)
+			} else {
+				require.NoError(t, err, "step %d: Remove(%d)", step, i)
+				require.Equal(t, model[i], v, "step %d: Remove(%d)", step, i)
+				model = slices.Delete(model, i, i+1)
+			}
+		case 7:
+			v := value()
+			i := slices.Index(model, v)
+			require.Equal(t, i >= 0, l.RemoveValue(v), "step %d: RemoveValue(%d)", step, v)
+			if i >= 0 {
+				model = slices.Delete(model, i, i+1)
+			}
+		case 8:
+			i := index(len(model))
+			v, err := l.Get(i)
+			if i < 0 || i >= len(model) {
+				require.Error(t, err, "step %d: Get(%d)", step, i)
+			} else {
+				require.NoError(t, err, "step %d: Get(%d)", step, i)
+				require.Equal(t, model[i], v, "step %d: Get(%d)", step, i)
+			}
+		case 9:
+			v := value()
+			require.Equal(t, slices.Contains(model, v), l.Contains(v), "step %d: Contains(%d)", step, v)
+			if !extended {
+				break
+			}
+			require.Equal(t, slices.Index(model, v), el.IndexOf(v), "step %d: IndexOf(%d)", step, v)
+			last := -1
+			for i, mv := range model {
+				if mv == v {
+					last = i
+				}
+			}
+			require.Equal(t, last, el.LastIndexOf(v), "step %d: LastIndexOf(%d)", step, v)
+		case 10:
+			reverse := r.Intn(2) == 0
+			l.Sort(reverse)
+			slices.Sort(model)
+			if reverse {
+				slices.Reverse(model)
+			}
+		case 11:
+			if r.Intn(10) == 0 {
+				l.Clear()
+				model = model[:0]
+			}
+		case 12:
+			i, v := index(len(model)), value()
+			old, err := el.Set(i, v)
+			if i < 0 || i >= len(model) {
+				require.Error(t, err, "step %d: Set(%d)", step, i)
+			} else {
+				require.NoError(t, err, "step %d: Set(%d)", step, i)
+				require.Equal(t, model[i], old, "step %d: Set(%d)", step, i)
+				model[i] = v
+			}
+		case 13:
+			i := index(len(model))
+			vals := make([]int, r.Intn(4))
+			for j := range vals {
+				vals[j] = value()
+			}
+			err := el.AddAll(i, vals...)
+			if i < 0 || i > len(model) {
+				require.Error(t, err, "step %d: AddAll(%d)", step, i)
+			} else {
+				require.NoError(t, err, "step %d: AddAll(%d)", step, i)
+				model = slices.Insert(model, i, vals...)
+			}
+		case 14:
+			k := r.Intn(5) + 2
+			pred := func(v int) bool { return v%k == 0 }
+			n := len(model)
+			model = slices.DeleteFunc(model, pred)
+			require.Equal(t, n-len(model), el.RemoveIf(pred), "step %d: RemoveIf", step)
+		case 15:
+			vals := []int{value(), value(), value(), value(), value(), value()}
+			n := len(model)
+			model = slices.DeleteFunc(model, func(v int) bool { return !slices.Contains(vals, v) })
+			require.Equal(t, n-len(model), el.RetainAll(vals...), "step %d: RetainAll(%v)", step, vals)
+		case 16:
+			d := r.Intn(10) - 5
+			fn := func(v int) int { return (v + d + 10) % 10 }
+			el.ReplaceAll(fn)
+			for i, v := range model {
+				model[i] = fn(v)
+			}
+		case 17:
+			el.Reverse()
+			slices.Reverse(model)
+		case 18:
+			i, j := index(len(model)), index(len(model))
+			err := el.Swap(i, j)
+			if i < 0 || i >= len(model) || j < 0 || j >= len(model) {
+				require.Error(t, err, "step %d: Swap(%d, %d)", step, i, j)
+			} else {
+				require.NoError(t, err, "step %d: Swap(%d, %d)", step, i, j)
+				model[i], model[j] = model[j], model[i]
+			}
+		case 19:
+			d := r.Intn(21) - 10
+			el.Rotate(d)
+			if n := len(model); n > 0 {
+				k := (d%n + n) % n
+				model = slices.Concat(model[n-k:], model[:n-k])
+			}
+		case 20:
+			from, to := index(len(model)), index(len(model))
+			sub, err := el.SubList(from, to)
+			if from < 0 || to > len(model) || from > to {
+				require.Error(t, err, "step %d: SubList(%d, %d)", step, from, to)
+			} else {
+				require.NoError(t, err, "step %d: SubList(%d, %d)", step, from, to)
+				require.Equal(t, model[from:to], nonNil(sub.Values()), "step %d: SubList(%d, %d)", step, from, to)
+			}
+			cl := el.Clone()
+			require.True(t, el.Equal(cl), "step %d: Clone", step)
+			cl.PushBack(0)
+			require.False(t, el.Equal(cl), "step %d: Clone", step)
+		default:
+			model = testListIteratorModel(t, r, step, el, model)
+		}
+		requireListEqual(t, step, l, model)
+	}
+}
+
+// testListIteratorModel applies random operations to a ListIterator of the list and a reference cursor,
+// it returns the reference slice after the operations.
+func testListIteratorModel(t *testing.T, r *rand.Rand, step int, l container.ExtendedListOf[int], model []int) []int {
+	it := l.ListIterator()
+	cursor, last := 0, -1
+	for n := r.Intn(20); n > 0; n-- {
+		require.Equal(t, cursor < len(model), it.HasNext(), "step %d: HasNext", step)
+		require.Equal(t, cursor > 0, it.HasPrev(), "step %d: HasPrev", step)
+		require.Equal(t, cursor, it.NextIndex(), "step %d: NextIndex", step)
+		require.Equal(t, cursor-1, it.PrevIndex(), "step %d: PrevIndex", step)
+
+		switch r.Intn(6) {
+		case 0, 1:
+			v, err := it.Next()
+			if cursor == len(model) {
+				require.ErrorIs(t, err, container.ErrNoSuchElement, "step %d: Next", step)
+				break
+			}
+			require.NoError(t, err, "step %d: Next", step)
+			require.Equal(t, model[cursor], v, "step %d: Next", step)
+			last = cursor
+			cursor++
+		case 2:
+			v, err := it.Prev()
+			if cursor == 0 {
+				require.ErrorIs(t, err, container.ErrNoSuchElement, "step %d: Prev", step)
+				break
+			}
+			require.NoError(t, err, "step %d: Prev", step)
+			cursor--
+			last = cursor
+			require.Equal(t, model[cursor], v, "step %d: Prev", step)
+		case 3:
+			v := r.Intn(10)
+			err := it.Set(v)
+			if last < 0 {
+				require.ErrorIs(t, err, container.ErrIllegalState, "step %d: Set", step)
+				break
+			}
+			require.NoError(t, err, "step %d: Set", step)
+			model[last] = v
+		case 4:
+			err := it.Remove()
+			if last < 0 {
+				require.ErrorIs(t, err, container.ErrIllegalState, "step %d: Remove", step)
+				break
+			}
+			require.NoError(t, err, "step %d: Remove", step)
+			model = slices.Delete(model, last, last+1)
+			if last < cursor {
+				cursor--
+			}
+			last = -1
+		default:
+			v := r.Intn(10)
+			if r.Intn(2) == 0 {
+				require.NoError(t, it.InsertBefore(v), "step %d: InsertBefore", step)
+				model = slices.Insert(model, cursor, v)
+				cursor++
+			} else {
+				require.NoError(t, it.InsertAfter(v), "step %d: InsertAfter", step)
+				model = slices.Insert(model, cursor, v)
+			}
+			last = -1
+		}
+	}
+	return model
+}
+
+func requireListEqual(t *testing.T, step int, l container.ListOf[int], model []int) {
+	t.Helper()
+	want := nonNil(model)
+	require.Equal(t, len(model), l.Len(), "step %d: Len", step)
+	require.Equal(t, len(model) == 0, l.IsEmpty(), "step %d: IsEmpty", step)
+	require.Equal(t, want, nonNil(l.Values()), "step %d: Values", step)
+	require.Equal(t, want, values(l.Iterator), "step %d: Iterator", step)
+	require.Equal(t, reversed(want), values(l.ReverseIterator), "step %d: ReverseIterator", step)
+	front, back := 0, 0
+	if len(model) > 0 {
+		front, back = model[0], model[len(model)-1]
+	}
+	require.Equal(t, front, l.Peek(), "step %d: Peek", step)
+	require.Equal(t, front, l.PeekFront(), "step %d: PeekFront", step)
+	require.Equal(t, back, l.PeekBack(), "step %d: PeekBack", step)
+}
+
+// nonNil returns vals, or an empty slice if vals is nil, so a nil slice is equal to an empty one.
+func nonNil[T any](vals []T) []T {
+	if vals == nil {
+		return []T{}
+	}
+	return vals
+}
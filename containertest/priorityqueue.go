@@ -0,0 +1,148 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containertest
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+)
+
+// TestPriorityQueue runs the conformance suite of container.Queue against the priority queues created by newQueue,
+// newQueue must return an empty queue which polls the least element first by the natural ordering of int.
+func TestPriorityQueue(t *testing.T, newQueue func() container.QueueOf[int], opts ...Option) {
+	c := newConfig(opts)
+	for _, tt := range []struct {
+		name string
+		test func(t *testing.T, newQueue func() container.QueueOf[int])
+	}{
+		{"Empty", testPriorityQueueEmpty},
+		{"Order", testPriorityQueueOrder},
+		{"Remove", testPriorityQueueRemove},
+	} {
+		t.Run(tt.name, func(t *testing.T) { tt.test(t, newQueue) })
+	}
+	t.Run("Model", func(t *testing.T) { testPriorityQueueModel(t, c, newQueue) })
+}
+
+func testPriorityQueueEmpty(t *testing.T, newQueue func() container.QueueOf[int]) {
+	q := newQueue()
+	assert.Zero(t, q.Len())
+	assert.True(t, q.IsEmpty())
+	assert.Zero(t, q.Peek())
+	assert.Zero(t, q.Poll())
+	assert.False(t, q.Contains(0))
+	q.Remove(0)
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+}
+
+func testPriorityQueueOrder(t *testing.T, newQueue func() container.QueueOf[int]) {
+	q := newQueue()
+	for _, v := range []int{5, 1, 4, 1, 3, 9, 2} {
+		q.Add(v)
+	}
+	assert.Equal(t, 7, q.Len())
+	assert.False(t, q.IsEmpty())
+	var got []int
+	for !q.IsEmpty() {
+		head := q.Peek()
+		v := q.Poll()
+		assert.Equal(t, head, v, "Peek should return the head")
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{1, 1, 2, 3, 4, 5, 9}, got)
+	assert.Zero(t, q.Poll())
+
+	q.Add(2)
+	q.Add(1)
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+	assert.Zero(t, q.Peek())
+	q.Add(3)
+	assert.Equal(t, 3, q.Poll(), "the queue should be reusable after Clear")
+}
+
+func testPriorityQueueRemove(t *testing.T, newQueue func() container.QueueOf[int]) {
+	q := newQueue()
+	for _, v := range []int{3, 1, 2, 1, 4} {
+		q.Add(v)
+	}
+	assert.True(t, q.Contains(2))
+	assert.False(t, q.Contains(5))
+
+	q.Remove(1)
+	assert.Equal(t, 4, q.Len())
+	assert.Equal(t, 1, q.Peek(), "Remove should remove a single instance")
+	q.Remove(5)
+	assert.Equal(t, 4, q.Len())
+	q.Remove(1)
+	q.Remove(4)
+	assert.Equal(t, 2, q.Poll())
+	assert.Equal(t, 3, q.Poll())
+	assert.True(t, q.IsEmpty())
+}
+
+// testPriorityQueueModel applies random operations to the queue and a reference sorted slice,
+// and compares them after every operation.
+func testPriorityQueueModel(t *testing.T, c config, newQueue func() container.QueueOf[int]) {
+	r := c.rand(t)
+	q := newQueue()
+	var model []int // sorted in ascending order
+
+	value := func() int { return r.Intn(20) }
+	for step := 0; step < c.steps; step++ {
+		switch r.Intn(6) {
+		case 0, 1:
+			v := value()
+			q.Add(v)
+			i, _ := slices.BinarySearch(model, v)
+			model = slices.Insert(model, i, v)
+		case 2:
+			want := 0
+			if len(model) > 0 {
+				want, model = model[0], model[1:]
+			}
+			require.Equal(t, want, q.Poll(), "step %d: Poll", step)
+		case 3:
+			v := value()
+			_, found := slices.BinarySearch(model, v)
+			require.Equal(t, found, q.Contains(v), "step %d: Contains(%d)", step, v)
+		case 4:
+			v := value()
+			q.Remove(v)
+			if i, found := slices.BinarySearch(model, v); found {
+				model = slices.Delete(model, i, i+1)
+			}
+		default:
+			if r.Intn(10) == 0 {
+				q.Clear()
+				model = model[:0]
+			}
+		}
+
+		require.Equal(t, len(model), q.Len(), "step %d: Len", step)
+		require.Equal(t, len(model) == 0, q.IsEmpty(), "step %d: IsEmpty", step)
+		head := 0
+		if len(model) > 0 {
+			head = model[0]
+		}
+		require.Equal(t, head, q.Peek(), "step %d: Peek", step)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containertest
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+)
+
+// TestQueue runs the conformance suite of container.Queue against the queues created by newQueue,
+// newQueue must return an empty FIFO queue which holds at least the steps of the model test.
+// If the queue implements container.ExtendedQueue, the extended methods are verified too.
+func TestQueue(t *testing.T, newQueue func() container.QueueOf[int], opts ...Option) {
+	c := newConfig(opts)
+	for _, tt := range []struct {
+		name string
+		test func(t *testing.T, newQueue func() container.QueueOf[int])
+	}{
+		{"Empty", testQueueEmpty},
+		{"FIFO", testQueueFIFO},
+		{"Remove", testQueueRemove},
+	} {
+		t.Run(tt.name, func(t *testing.T) { tt.test(t, newQueue) })
+	}
+	t.Run("Model", func(t *testing.T) { testQueueModel(t, c, newQueue) })
+}
+
+func testQueueEmpty(t *testing.T, newQueue func() container.QueueOf[int]) {
+	q := newQueue()
+	assert.Zero(t, q.Len())
+	assert.True(t, q.IsEmpty())
+	assert.Zero(t, q.Peek())
+	assert.Zero(t, q.Poll())
+	assert.False(t, q.Contains(0))
+	q.Remove(0)
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+
+	if eq, ok := q.(container.ExtendedQueueOf[int]); ok {
+		assert.Empty(t, eq.Values())
+		assert.Empty(t, values(eq.Iterator))
+		assert.Empty(t, values(eq.ReverseIterator))
+		_, err := eq.Get(0)
+		assert.Error(t, err)
+		assert.Zero(t, eq.RemoveIf(func(int) bool { return true }))
+	}
+}
+
+func testQueueFIFO(t *testing.T, newQueue func() container.QueueOf[int]) {
+	q := newQueue()
+	for i := 1; i <= 5; i++ {
+		q.Add(i)
+		assert.Equal(t, 1, q.Peek())
+		assert.Equal(t, i, q.Len())
+	}
+	assert.False(t, q.IsEmpty())
+	for i := 1; i <= 5; i++ {
+		assert.Equal(t, i, q.Peek())
+		assert.Equal(t, i, q.Poll())
+	}
+	assert.True(t, q.IsEmpty())
+	assert.Zero(t, q.Poll())
+
+	q.Add(1)
+	q.Add(2)
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+	assert.Zero(t, q.Peek())
+	q.Add(3)
+	assert.Equal(t, 3, q.Poll(), "the queue should be reusable after Clear")
+}
+
+func testQueueRemove(t *testing.T, newQueue func() container.QueueOf[int]) {
+	q := newQueue()
+	for _, v := range []int{1, 2, 3, 2, 4} {
+		q.Add(v)
+	}
+	assert.True(t, q.Contains(2))
+	assert.False(t, q.Contains(5))
+
+	q.Remove(2)
+	assert.Equal(t, 4, q.Len())
+	assert.True(t, q.Contains(2), "Remove should remove a single instance")
+	q.Remove(5)
+	assert.Equal(t, 4, q.Len())
+	q.Remove(1)
+	q.Remove(4)
+	assert.Equal(t, 2, q.Len())
+	assert.Equal(t, 3, q.Poll())
+	assert.Equal(t, 2, q.Poll())
+	assert.True(t, q.IsEmpty())
+}
+
+// testQueueModel applies random operations to the queue and a reference slice, and compares them after every operation.
+func testQueueModel(t *testing.T, c config, newQueue func() container.QueueOf[int]) {
+	r := c.rand(t)
+	q := newQueue()
+	eq, extended := q.(container.ExtendedQueueOf[int])
+	var model []int
+
+	value := func() int { return r.Intn(10) }
+	for step := 0; step < c.steps; step++ {
+		switch r.Intn(8) {
+		case 0, 1:
+			v := value()
+			q.Add(v)
+			model = append(model, v)
+		case 2:
+			want := 0
+			if len(model) > 0 {
+				want, model = model[0], model[1:]
+			}
+			require.Equal(t, want, q.Poll(), "step %d: Poll", step)
+		case 3:
+			v := value()
+			require.Equal(t, slices.Contains(model, v), q.Contains(v), "step %d: Contains(%d)", step, v)
+		case 4:
+			v := value()
+			q.Remove(v)
+			if i := slices.Index(model, v); i >= 0 {
+				model = slices.Delete(model, i, i+1)
+			}
+		case 5:
+			if !extended {
+				break
+			}
+			vals := make([]int, r.Intn(4))
+			for i := range vals {
+				vals[i] = value()
+			}
+			eq.AddAll(vals...)
+			model = append(model, vals...)
+		case 6:
+			if !extended {
+				break
+			}
+			k := r.Intn(5) + 2
+			pred := func(v int) bool { return v%k == 0 }
+			n := len(model)
+			model = slices.DeleteFunc(model, pred)
+			require.Equal(t, n-len(model), eq.RemoveIf(pred), "step %d: RemoveIf", step)
+		default:
+			if r.Intn(10) == 0 {
+				q.Clear()
+				model = model[:0]
+			}
+		}
+
+		require.Equal(t, len(model), q.Len(), "step %d: Len", step)
+		require.Equal(t, len(model) == 0, q.IsEmpty(), "step %d: IsEmpty", step)
+		head := 0
+		if len(model) > 0 {
+			head = model[0]
+		}
+		require.Equal(t, head, q.Peek(), "step %d: Peek", step)
+		if extended {
+			want := nonNil(model)
+			require.Equal(t, want, nonNil(eq.Values()), "step %d: Values", step)
+			require.Equal(t, want, values(eq.Iterator), "step %d: Iterator", step)
+			require.Equal(t, reversed(want), values(eq.ReverseIterator), "step %d: ReverseIterator", step)
+			i := r.Intn(len(model)+2) - 1
+			v, err := eq.Get(i)
+			if i < 0 || i >= len(model) {
+				require.Error(t, err, "step %d: Get(%d)", step, i)
+			} else {
+				require.NoError(t, err, "step %d: Get(%d)", step, i)
+				require.Equal(t, model[i], v, "step %d: Get(%d)", step, i)
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containertest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+)
+
+// TestStack runs the conformance suite of container.Stack against the stacks created by newStack,
+// newStack must return an empty LIFO stack which holds at least the steps of the model test.
+func TestStack(t *testing.T, newStack func() container.StackOf[int], opts ...Option) {
+	c := newConfig(opts)
+	for _, tt := range []struct {
+		name string
+		test func(t *testing.T, newStack func() container.StackOf[int])
+	}{
+		{"Empty", testStackEmpty},
+		{"LIFO", testStackLIFO},
+	} {
+		t.Run(tt.name, func(t *testing.T) { tt.test(t, newStack) })
+	}
+	t.Run("Model", func(t *testing.T) { testStackModel(t, c, newStack) })
+}
+
+func testStackEmpty(t *testing.T, newStack func() container.StackOf[int]) {
+	s := newStack()
+	assert.Zero(t, s.Len())
+	assert.True(t, s.IsEmpty())
+	assert.Zero(t, s.Peek())
+	assert.Zero(t, s.Pop())
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+}
+
+func testStackLIFO(t *testing.T, newStack func() container.StackOf[int]) {
+	s := newStack()
+	for i := 1; i <= 5; i++ {
+		s.Push(i)
+		assert.Equal(t, i, s.Peek())
+		assert.Equal(t, i, s.Len())
+	}
+	assert.False(t, s.IsEmpty())
+	for i := 5; i >= 1; i-- {
+		assert.Equal(t, i, s.Peek())
+		assert.Equal(t, i, s.Pop())
+	}
+	assert.True(t, s.IsEmpty())
+	assert.Zero(t, s.Pop())
+
+	s.Push(1)
+	s.Push(2)
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.Zero(t, s.Peek())
+	s.Push(3)
+	assert.Equal(t, 3, s.Pop(), "the stack should be reusable after Clear")
+}
+
+// testStackModel applies random operations to the stack and a reference slice, and compares them after every operation.
+func testStackModel(t *testing.T, c config, newStack func() container.StackOf[int]) {
+	r := c.rand(t)
+	s := newStack()
+	var model []int
+
+	for step := 0; step < c.steps; step++ {
+		switch r.Intn(5) {
+		case 0, 1:
+			v := r.Intn(10)
+			s.Push(v)
+			model = append(model, v)
+		case 2, 3:
+			want := 0
+			if len(model) > 0 {
+				want, model = model[len(model)-1], model[:len(model)-1]
+			}
+			require.Equal(t, want, s.Pop(), "step %d: Pop", step)
+		default:
+			if r.Intn(10) == 0 {
+				s.Clear()
+				model = model[:0]
+			}
+		}
+
+		require.Equal(t, len(model), s.Len(), "step %d: Len", step)
+		require.Equal(t, len(model) == 0, s.IsEmpty(), "step %d: IsEmpty", step)
+		top := 0
+		if len(model) > 0 {
+			top = model[len(model)-1]
+		}
+		require.Equal(t, top, s.Peek(), "step %d: Peek", step)
+	}
+}
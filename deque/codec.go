@@ -0,0 +1,60 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deque
+
+import (
+	"github.com/thinkgos/container"
+)
+
+// MarshalJSON implement json.Marshaler, the elements are encoded as a JSON array from front to back.
+// The elements of interface type are encoded as container.JSONValue.
+func (sf *DequeOf[T]) MarshalJSON() ([]byte, error) { return container.MarshalJSONValues(sf.Values()) }
+
+// UnmarshalJSON implement json.Unmarshaler, it replaces the elements of this Deque, the comparator is kept.
+func (sf *DequeOf[T]) UnmarshalJSON(data []byte) error {
+	values, err := container.UnmarshalJSONValues[T](data)
+	if err != nil {
+		return err
+	}
+	sf.reset(values)
+	return nil
+}
+
+// MarshalBinary implement encoding.BinaryMarshaler, the elements are encoded by gob from front to back.
+func (sf *DequeOf[T]) MarshalBinary() ([]byte, error) { return container.GobEncode(sf.Values()) }
+
+// UnmarshalBinary implement encoding.BinaryUnmarshaler, it replaces the elements of this Deque, the comparator is kept.
+func (sf *DequeOf[T]) UnmarshalBinary(data []byte) error {
+	var values []T
+	if err := container.GobDecode(data, &values); err != nil {
+		return err
+	}
+	sf.reset(values)
+	return nil
+}
+
+// GobEncode implement gob.GobEncoder.
+func (sf *DequeOf[T]) GobEncode() ([]byte, error) { return sf.MarshalBinary() }
+
+// GobDecode implement gob.GobDecoder.
+func (sf *DequeOf[T]) GobDecode(data []byte) error { return sf.UnmarshalBinary(data) }
+
+func (sf *DequeOf[T]) reset(values []T) {
+	sf.Clear()
+	if len(values) > 0 {
+		sf.buf = make([]T, roundCap(len(values)))
+		sf.size = copy(sf.buf, values)
+	}
+}
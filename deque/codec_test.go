@@ -0,0 +1,49 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deque
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDequeCodec(t *testing.T) {
+	d := New()
+	d.PushBack(1)
+	d.PushBack("hello")
+	d.PushFront(int64(2))
+	d.PushFront(nil)
+
+	data, err := json.Marshal(d)
+	require.NoError(t, err)
+	got := New()
+	got.Add("replaced")
+	require.NoError(t, json.Unmarshal(data, got))
+	assert.Equal(t, d.Values(), got.Values())
+
+	data, err = d.MarshalBinary()
+	require.NoError(t, err)
+	var gotZero Deque
+	require.NoError(t, gotZero.UnmarshalBinary(data))
+	assert.Equal(t, d.Values(), gotZero.Values())
+	gotZero.PushFront(0)
+	assert.Equal(t, 5, gotZero.Len())
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), got))
+	assert.Error(t, got.UnmarshalBinary([]byte("bad")))
+}
@@ -0,0 +1,31 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deque
+
+import (
+	"testing"
+
+	"github.com/thinkgos/container"
+	"github.com/thinkgos/container/containertest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Run("Queue", func(t *testing.T) {
+		containertest.TestQueue(t, func() container.QueueOf[int] { return NewOf[int]() })
+	})
+	t.Run("Stack", func(t *testing.T) {
+		containertest.TestStack(t, func() container.StackOf[int] { return NewOf[int]() })
+	})
+}
@@ -0,0 +1,305 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deque implements a double-ended queue based on a growable circular buffer.
+package deque
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/thinkgos/container"
+	"github.com/thinkgos/container/comparator"
+)
+
+var _ container.Deque = (*Deque)(nil)
+var _ container.Queue = (*Deque)(nil)
+var _ container.Stack = (*Deque)(nil)
+
+// minCap is the minimum capacity of the buffer, the capacity is always a power of two.
+const minCap = 16
+
+// Deque is a double-ended queue of interface{} elements.
+type Deque = DequeOf[interface{}]
+
+// DequeOf is a double-ended queue of T elements, based on a growable circular buffer.
+// The operations at both ends are amortized O(1), the indexed access is O(1).
+// As a Queue, the elements are added to the back and polled from the front,
+// as a Stack, the elements are pushed to and popped from the front.
+type DequeOf[T any] struct {
+	buf  []T // len(buf) is a power of two or zero.
+	head int
+	size int
+	cmp  comparator.CompareFunc[T]
+}
+
+type options struct {
+	cmp      comparator.Comparator
+	capacity int
+}
+
+// Option option for New.
+type Option func(o *options)
+
+// WithCap with the initial capacity.
+func WithCap(capacity int) Option {
+	return func(o *options) {
+		o.capacity = capacity
+	}
+}
+
+// WithComparator with user's Comparator.
+func WithComparator(cmp comparator.Comparator) Option {
+	return func(o *options) {
+		o.cmp = cmp
+	}
+}
+
+// WithCompareFunc with user's CompareFunc of T elements.
+func WithCompareFunc[T any](f comparator.CompareFunc[T]) Option {
+	return WithComparator(f)
+}
+
+// New initializes and returns a Deque.
+func New(opts ...Option) *Deque {
+	return NewOf[interface{}](opts...)
+}
+
+// NewOf initializes and returns a DequeOf of T elements.
+func NewOf[T any](opts ...Option) *DequeOf[T] {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	sf := &DequeOf[T]{cmp: comparator.FuncOf[T](o.cmp)}
+	if o.capacity > 0 {
+		sf.buf = make([]T, roundCap(o.capacity))
+	}
+	return sf
+}
+
+// Len returns the number of elements of this Deque.
+func (sf *DequeOf[T]) Len() int { return sf.size }
+
+// IsEmpty returns true if this Deque contains no elements.
+func (sf *DequeOf[T]) IsEmpty() bool { return sf.size == 0 }
+
+// Clear removes all the elements from this Deque.
+func (sf *DequeOf[T]) Clear() { sf.buf, sf.head, sf.size = nil, 0, 0 } // should set nil for gc
+
+// PushFront inserts an element at the front of this Deque.
+func (sf *DequeOf[T]) PushFront(v T) {
+	sf.grow()
+	sf.head = sf.index(-1)
+	sf.buf[sf.head] = v
+	sf.size++
+}
+
+// PushBack inserts an element at the back of this Deque.
+func (sf *DequeOf[T]) PushBack(v T) {
+	sf.grow()
+	sf.buf[sf.index(sf.size)] = v
+	sf.size++
+}
+
+// PopFront retrieves and removes the front element of this Deque, or return the zero value if this Deque is empty.
+func (sf *DequeOf[T]) PopFront() (val T) {
+	if sf.size == 0 {
+		return val
+	}
+	var zero T
+
+	val = sf.buf[sf.head]
+	sf.buf[sf.head] = zero // should set zero for gc
+	sf.head = sf.index(1)
+	sf.size--
+	sf.shrink()
+	return val
+}
+
+// PopBack retrieves and removes the back element of this Deque, or return the zero value if this Deque is empty.
+func (sf *DequeOf[T]) PopBack() (val T) {
+	if sf.size == 0 {
+		return val
+	}
+	var zero T
+
+	i := sf.index(sf.size - 1)
+	val = sf.buf[i]
+	sf.buf[i] = zero // should set zero for gc
+	sf.size--
+	sf.shrink()
+	return val
+}
+
+// PeekFront retrieves, but does not remove, the front element of this Deque,
+// or return the zero value if this Deque is empty.
+func (sf *DequeOf[T]) PeekFront() (val T) {
+	if sf.size > 0 {
+		val = sf.buf[sf.head]
+	}
+	return val
+}
+
+// PeekBack retrieves, but does not remove, the back element of this Deque,
+// or return the zero value if this Deque is empty.
+func (sf *DequeOf[T]) PeekBack() (val T) {
+	if sf.size > 0 {
+		val = sf.buf[sf.index(sf.size-1)]
+	}
+	return val
+}
+
+// At returns the element at the specified position from the front. The index must be in the range of [0, size).
+func (sf *DequeOf[T]) At(index int) (T, error) {
+	if index < 0 || index >= sf.size {
+		var zero T
+		return zero, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
+	}
+	return sf.buf[sf.index(index)], nil
+}
+
+// Set replaces the element at the specified position from the front. The index must be in the range of [0, size).
+func (sf *DequeOf[T]) Set(index int, v T) error {
+	if index < 0 || index >= sf.size {
+		return fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
+	}
+	sf.buf[sf.index(index)] = v
+	return nil
+}
+
+// Add inserts an element at the back of this Deque, same as PushBack, it implements container.Queue.
+func (sf *DequeOf[T]) Add(v T) { sf.PushBack(v) }
+
+// Poll retrieves and removes the front element of this Deque, same as PopFront, it implements container.Queue.
+func (sf *DequeOf[T]) Poll() T { return sf.PopFront() }
+
+// Push inserts an element at the front of this Deque, same as PushFront, it implements container.Stack.
+func (sf *DequeOf[T]) Push(v T) { sf.PushFront(v) }
+
+// Pop retrieves and removes the front element of this Deque, same as PopFront, it implements container.Stack.
+func (sf *DequeOf[T]) Pop() T { return sf.PopFront() }
+
+// Peek retrieves, but does not remove, the front element of this Deque, same as PeekFront.
+func (sf *DequeOf[T]) Peek() T { return sf.PeekFront() }
+
+// Contains returns true if this Deque contains the specified element.
+func (sf *DequeOf[T]) Contains(val T) bool { return sf.indexOf(val) >= 0 }
+
+// Remove removes the first occurrence of the specified element from this Deque, if it is present.
+func (sf *DequeOf[T]) Remove(val T) {
+	if idx := sf.indexOf(val); idx >= 0 {
+		sf.removeAt(idx)
+	}
+}
+
+// Values returns a copy of all the elements from front to back.
+func (sf *DequeOf[T]) Values() []T {
+	values := make([]T, sf.size)
+	n := copy(values, sf.buf[sf.head:min(sf.head+sf.size, len(sf.buf))])
+	copy(values[n:], sf.buf[:sf.size-n])
+	return values
+}
+
+// All returns an iterator over the elements in this Deque from front to back.
+func (sf *DequeOf[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < sf.size; i++ {
+			if !yield(sf.buf[sf.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements in this Deque from back to front.
+func (sf *DequeOf[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := sf.size - 1; i >= 0; i-- {
+			if !yield(sf.buf[sf.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// index returns the position in the buffer of the i-th element from the front, i may be -1.
+func (sf *DequeOf[T]) index(i int) int { return (sf.head + i) & (len(sf.buf) - 1) }
+
+// grow doubles the buffer if it is full.
+func (sf *DequeOf[T]) grow() {
+	if sf.size == len(sf.buf) {
+		sf.resize(max(minCap, len(sf.buf)*2))
+	}
+}
+
+// shrink halves the buffer when len <= cap/4, like arraylist.
+func (sf *DequeOf[T]) shrink() {
+	if len(sf.buf) > 1024 && sf.size <= len(sf.buf)/4 {
+		sf.resize(len(sf.buf) / 2)
+	}
+}
+
+func (sf *DequeOf[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	n := copy(buf, sf.buf[sf.head:min(sf.head+sf.size, len(sf.buf))])
+	copy(buf[n:sf.size], sf.buf[:sf.size-n])
+	sf.buf, sf.head = buf, 0
+}
+
+// removeAt removes the i-th element from the front, the shorter side is moved.
+func (sf *DequeOf[T]) removeAt(i int) {
+	var zero T
+
+	if i < sf.size/2 {
+		for j := i; j > 0; j-- {
+			sf.buf[sf.index(j)] = sf.buf[sf.index(j-1)]
+		}
+		sf.buf[sf.head] = zero // should set zero for gc
+		sf.head = sf.index(1)
+	} else {
+		for j := i; j < sf.size-1; j++ {
+			sf.buf[sf.index(j)] = sf.buf[sf.index(j+1)]
+		}
+		sf.buf[sf.index(sf.size-1)] = zero // should set zero for gc
+	}
+	sf.size--
+	sf.shrink()
+}
+
+// indexOf returns the index of the first occurrence of the specified element from the front, or -1.
+func (sf *DequeOf[T]) indexOf(val T) int {
+	for i := 0; i < sf.size; i++ {
+		if sf.compare(sf.buf[sf.index(i)], val) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (sf *DequeOf[T]) compare(v1, v2 T) bool {
+	if sf.cmp != nil {
+		return sf.cmp(v1, v2) == 0
+	}
+	return any(v1) == any(v2)
+}
+
+// roundCap returns the smallest power of two >= n and >= minCap.
+func roundCap(n int) int {
+	c := minCap
+	for c < n {
+		c <<= 1
+	}
+	return c
+}
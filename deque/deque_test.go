@@ -0,0 +1,160 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deque
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+	"github.com/thinkgos/container/comparator"
+)
+
+func TestDeque(t *testing.T) {
+	d := New()
+	assert.True(t, d.IsEmpty())
+	assert.Nil(t, d.PeekFront())
+	assert.Nil(t, d.PeekBack())
+	assert.Nil(t, d.PopFront())
+	assert.Nil(t, d.PopBack())
+
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+	assert.Equal(t, 4, d.Len())
+	assert.Equal(t, []interface{}{0, 1, 2, 3}, d.Values())
+	assert.Equal(t, 0, d.PeekFront())
+	assert.Equal(t, 3, d.PeekBack())
+
+	v, err := d.At(2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+	require.NoError(t, d.Set(2, "two"))
+	v, err = d.At(2)
+	require.NoError(t, err)
+	assert.Equal(t, "two", v)
+	_, err = d.At(4)
+	assert.Error(t, err)
+	_, err = d.At(-1)
+	assert.Error(t, err)
+	assert.Error(t, d.Set(4, 4))
+
+	assert.Equal(t, 0, d.PopFront())
+	assert.Equal(t, 3, d.PopBack())
+	assert.Equal(t, []interface{}{1, "two"}, d.Values())
+
+	d.Clear()
+	assert.True(t, d.IsEmpty())
+	assert.Empty(t, d.Values())
+}
+
+func TestDequeWrapAndResize(t *testing.T) {
+	d := NewOf[int](WithCap(3))
+	assert.Equal(t, minCap, len(d.buf))
+
+	// wrap around the buffer
+	for i := 0; i < 10; i++ {
+		d.PushBack(i)
+	}
+	for i := 0; i < 8; i++ {
+		d.PopFront()
+	}
+	for i := 10; i < 20; i++ {
+		d.PushBack(i)
+	}
+	for i := -1; i >= -5; i-- {
+		d.PushFront(i)
+	}
+	expected := []int{-5, -4, -3, -2, -1, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}
+	assert.Equal(t, expected, d.Values())
+	assert.Equal(t, expected, slices.Collect(d.All()))
+	slices.Reverse(expected)
+	assert.Equal(t, expected, slices.Collect(d.Backward()))
+	for i := range d.Len() {
+		v, err := d.At(i)
+		require.NoError(t, err)
+		assert.Equal(t, expected[len(expected)-1-i], v)
+	}
+
+	// grow and shrink
+	d.Clear()
+	for i := 0; i < 10000; i++ {
+		d.PushFront(i)
+	}
+	assert.Equal(t, 16384, len(d.buf))
+	for i := 0; i < 9900; i++ {
+		assert.Equal(t, i, d.PopBack())
+	}
+	assert.Equal(t, 100, d.Len())
+	assert.Less(t, len(d.buf), 1024*2)
+	assert.Equal(t, 9999, d.PeekFront())
+	assert.Equal(t, 9900, d.PeekBack())
+}
+
+func TestDequeQueueStack(t *testing.T) {
+	var q container.QueueOf[int] = NewOf[int]()
+	q.Add(1)
+	q.Add(2)
+	q.Add(3)
+	assert.True(t, q.Contains(2))
+	assert.Equal(t, 1, q.Peek())
+	assert.Equal(t, 1, q.Poll())
+	q.Remove(3)
+	assert.False(t, q.Contains(3))
+	assert.Equal(t, 1, q.Len())
+
+	var s container.StackOf[int] = NewOf[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	assert.Equal(t, 3, s.Peek())
+	assert.Equal(t, 3, s.Pop())
+	assert.Equal(t, 2, s.Pop())
+	assert.Equal(t, 1, s.Pop())
+	assert.Equal(t, 0, s.Pop())
+
+	var d container.DequeOf[int] = NewOf[int]()
+	d.PushBack(1)
+	assert.Equal(t, 1, d.PopBack())
+}
+
+func TestDequeRemove(t *testing.T) {
+	for n := 1; n <= 20; n++ {
+		for i := 0; i < n; i++ {
+			d := NewOf[int]()
+			expected := make([]int, 0, n)
+			for j := 0; j < n; j++ {
+				d.PushFront(n - 1 - j) // wrap around
+				expected = append(expected, j)
+			}
+			d.Remove(i)
+			expected = slices.Delete(expected, i, i+1)
+			assert.Equal(t, expected, d.Values())
+		}
+	}
+
+	d := New(WithCompareFunc(func(a, b interface{}) int { return comparator.Compare(a.(string)[0], b.(string)[0]) }))
+	d.Add("apple")
+	d.Add("banana")
+	assert.True(t, d.Contains("box"))
+	d.Remove("bar")
+	assert.Equal(t, []interface{}{"apple"}, d.Values())
+	d.Remove("cherry")
+	assert.Equal(t, 1, d.Len())
+}
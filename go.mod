@@ -1,10 +1,15 @@
 module github.com/thinkgos/container
 
-go 1.15
+go 1.23
 
 require (
-	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/stretchr/testify v1.7.0
 	github.com/things-go/sets v0.0.1
+	golang.org/x/text v0.13.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b // indirect
 )
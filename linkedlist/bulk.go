@@ -0,0 +1,192 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linkedlist
+
+import (
+	"container/list"
+	"fmt"
+	"slices"
+
+	"github.com/thinkgos/container"
+)
+
+// Set replaces the element at the specified position in this list with val, it returns the old element.
+// It returns an error if the index is out of range.
+func (sf *LinkedListOf[T]) Set(index int, val T) (old T, err error) {
+	if index < 0 || index >= sf.Len() {
+		return old, fmt.Errorf("index out of range, index: %d, len: %d", index, sf.Len())
+	}
+	e := sf.getElement(index)
+	old = value[T](e)
+	e.Value = val
+	return old, nil
+}
+
+// IndexOf returns the index of the first occurrence of the specified element, or -1 if it isn't present.
+func (sf *LinkedListOf[T]) IndexOf(val T) int { return sf.indexOf(val) }
+
+// LastIndexOf returns the index of the last occurrence of the specified element, or -1 if it isn't present.
+func (sf *LinkedListOf[T]) LastIndexOf(val T) int {
+	for index, e := sf.Len()-1, sf.l.Back(); e != nil; e = e.Prev() {
+		if sf.compare(val, value[T](e)) {
+			return index
+		}
+		index--
+	}
+	return -1
+}
+
+// SubList returns a new list with a copy of the elements in the range of [from, to).
+// It returns an error if the range is out of range.
+func (sf *LinkedListOf[T]) SubList(from, to int) (container.ExtendedListOf[T], error) {
+	if from < 0 || to > sf.Len() || from > to {
+		return nil, fmt.Errorf("range out of range, from: %d, to: %d, len: %d", from, to, sf.Len())
+	}
+	sub := &LinkedListOf[T]{l: list.New(), cmp: sf.cmp}
+	if from < to {
+		for i, e := from, sf.getElement(from); i < to; i, e = i+1, e.Next() {
+			sub.l.PushBack(e.Value)
+		}
+	}
+	return sub, nil
+}
+
+// AddAll inserts the specified elements at the specified position in this list in order.
+// It returns an error if the index is out of range.
+func (sf *LinkedListOf[T]) AddAll(index int, vals ...T) error {
+	if index < 0 || index > sf.Len() {
+		return fmt.Errorf("index out of range, index: %d, len: %d", index, sf.Len())
+	}
+	if len(vals) == 0 {
+		return nil
+	}
+	if index == sf.Len() {
+		for _, v := range vals {
+			sf.l.PushBack(v)
+		}
+	} else {
+		mark := sf.getElement(index)
+		for _, v := range vals {
+			sf.l.InsertBefore(v, mark)
+		}
+	}
+	sf.modCount++
+	return nil
+}
+
+// RemoveIf removes all of the elements that satisfy the given predicate in a single pass.
+// It returns the number of the removed elements.
+func (sf *LinkedListOf[T]) RemoveIf(pred func(T) bool) int {
+	removed := 0
+	for e := sf.l.Front(); e != nil; {
+		next := e.Next()
+		if pred(value[T](e)) {
+			sf.l.Remove(e)
+			removed++
+		}
+		e = next
+	}
+	if removed > 0 {
+		sf.modCount++
+	}
+	return removed
+}
+
+// RetainAll retains only the elements which are equal to one of vals.
+// It returns the number of the removed elements.
+func (sf *LinkedListOf[T]) RetainAll(vals ...T) int {
+	return sf.RemoveIf(func(v T) bool {
+		return !slices.ContainsFunc(vals, func(val T) bool { return sf.compare(val, v) })
+	})
+}
+
+// ReplaceAll replaces each element with the result of fn applied to it.
+func (sf *LinkedListOf[T]) ReplaceAll(fn func(T) T) {
+	for e := sf.l.Front(); e != nil; e = e.Next() {
+		e.Value = fn(value[T](e))
+	}
+}
+
+// Reverse reverses the order of the elements, the elements are relinked in place.
+func (sf *LinkedListOf[T]) Reverse() {
+	if sf.Len() <= 1 {
+		return
+	}
+	for e := sf.l.Front().Next(); e != nil; {
+		next := e.Next()
+		sf.l.MoveToFront(e)
+		e = next
+	}
+	sf.modCount++
+}
+
+// Swap swaps the elements at the specified positions.
+// It returns an error if any index is out of range.
+func (sf *LinkedListOf[T]) Swap(i, j int) error {
+	if i < 0 || i >= sf.Len() || j < 0 || j >= sf.Len() {
+		return fmt.Errorf("index out of range, i: %d, j: %d, len: %d", i, j, sf.Len())
+	}
+	if i != j {
+		ei, ej := sf.getElement(i), sf.getElement(j)
+		ei.Value, ej.Value = ej.Value, ei.Value
+	}
+	return nil
+}
+
+// Rotate rotates the elements by the specified distance, the elements are relinked in place,
+// the element at index i moves to index (i + distance) mod size, distance may be negative.
+// It takes O(min(d, size-d)) moves, d is the normalized distance.
+func (sf *LinkedListOf[T]) Rotate(distance int) {
+	n := sf.Len()
+	if n == 0 {
+		return
+	}
+	d := (distance%n + n) % n
+	if d == 0 {
+		return
+	}
+	if d <= n/2 {
+		for ; d > 0; d-- {
+			sf.l.MoveToFront(sf.l.Back())
+		}
+	} else {
+		for d = n - d; d > 0; d-- {
+			sf.l.MoveToBack(sf.l.Front())
+		}
+	}
+	sf.modCount++
+}
+
+// Clone returns a shallow copy of this list, the comparator is kept.
+func (sf *LinkedListOf[T]) Clone() container.ExtendedListOf[T] {
+	c := &LinkedListOf[T]{l: list.New(), cmp: sf.cmp}
+	c.l.PushBackList(sf.l)
+	return c
+}
+
+// Equal returns true if other has the same elements in the same order,
+// the elements are compared by the comparator of this list.
+func (sf *LinkedListOf[T]) Equal(other container.ListOf[T]) bool {
+	if other == nil || sf.Len() != other.Len() {
+		return false
+	}
+	e, equal := sf.l.Front(), true
+	other.Iterator(func(v T) bool {
+		equal = sf.compare(value[T](e), v)
+		e = e.Next()
+		return equal
+	})
+	return equal
+}
@@ -0,0 +1,75 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linkedlist
+
+import (
+	"container/list"
+
+	"github.com/thinkgos/container"
+)
+
+// MarshalJSON implement json.Marshaler, the elements are encoded as a JSON array in proper sequence.
+// The elements of interface type are encoded as container.JSONValue.
+func (sf *LinkedListOf[T]) MarshalJSON() ([]byte, error) {
+	return container.MarshalJSONValues(sf.values())
+}
+
+// UnmarshalJSON implement json.Unmarshaler, it replaces the elements of this list, the comparator is kept.
+func (sf *LinkedListOf[T]) UnmarshalJSON(data []byte) error {
+	values, err := container.UnmarshalJSONValues[T](data)
+	if err != nil {
+		return err
+	}
+	sf.reset(values)
+	return nil
+}
+
+// MarshalBinary implement encoding.BinaryMarshaler, the elements are encoded by gob in proper sequence.
+func (sf *LinkedListOf[T]) MarshalBinary() ([]byte, error) { return container.GobEncode(sf.values()) }
+
+// UnmarshalBinary implement encoding.BinaryUnmarshaler, it replaces the elements of this list, the comparator is kept.
+func (sf *LinkedListOf[T]) UnmarshalBinary(data []byte) error {
+	var values []T
+	if err := container.GobDecode(data, &values); err != nil {
+		return err
+	}
+	sf.reset(values)
+	return nil
+}
+
+// GobEncode implement gob.GobEncoder.
+func (sf *LinkedListOf[T]) GobEncode() ([]byte, error) { return sf.MarshalBinary() }
+
+// GobDecode implement gob.GobDecoder.
+func (sf *LinkedListOf[T]) GobDecode(data []byte) error { return sf.UnmarshalBinary(data) }
+
+func (sf *LinkedListOf[T]) values() []T {
+	if sf.l == nil {
+		return nil
+	}
+	return sf.Values()
+}
+
+// reset replaces the elements with values, it initializes the list if it is the zero value.
+func (sf *LinkedListOf[T]) reset(values []T) {
+	if sf.l == nil {
+		sf.l = list.New()
+	}
+	sf.l.Init()
+	for _, v := range values {
+		sf.l.PushBack(v)
+	}
+	sf.modCount++
+}
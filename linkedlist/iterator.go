@@ -0,0 +1,170 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linkedlist
+
+import (
+	"container/list"
+	"fmt"
+
+	"github.com/thinkgos/container"
+)
+
+var _ container.ListIterator = (*listIterator[interface{}])(nil)
+
+// listIterator is the list iterator of LinkedListOf, every operation is O(1).
+type listIterator[T any] struct {
+	l *LinkedListOf[T]
+	// next is the element returned by Next, nil at the end, index is its index.
+	next  *list.Element
+	index int
+	// last is the element last returned by Next or Prev, nil if none.
+	last     *list.Element
+	modCount int
+}
+
+// ListIterator returns a bidirectional cursor over the elements in this list, starting from the front.
+func (sf *LinkedListOf[T]) ListIterator() container.ListIteratorOf[T] {
+	return &listIterator[T]{l: sf, next: sf.l.Front(), modCount: sf.modCount}
+}
+
+// ListIteratorAt returns a bidirectional cursor over the elements in this list, starting from the index.
+// The index must be in the range of [0, size], the first Next returns the element at the index.
+func (sf *LinkedListOf[T]) ListIteratorAt(index int) (container.ListIteratorOf[T], error) {
+	if index < 0 || index > sf.Len() {
+		return nil, fmt.Errorf("index out of range, index: %d, len: %d", index, sf.Len())
+	}
+	var next *list.Element
+	if index < sf.Len() {
+		next = sf.getElement(index)
+	}
+	return &listIterator[T]{l: sf, next: next, index: index, modCount: sf.modCount}, nil
+}
+
+// HasNext implement container.ListIterator.
+func (it *listIterator[T]) HasNext() bool { return it.next != nil }
+
+// Next implement container.ListIterator.
+func (it *listIterator[T]) Next() (val T, err error) {
+	if err = it.check(); err != nil {
+		return val, err
+	}
+	if it.next == nil {
+		return val, container.ErrNoSuchElement
+	}
+	it.last = it.next
+	it.next = it.next.Next()
+	it.index++
+	return value[T](it.last), nil
+}
+
+// HasPrev implement container.ListIterator.
+func (it *listIterator[T]) HasPrev() bool { return it.index > 0 }
+
+// Prev implement container.ListIterator.
+func (it *listIterator[T]) Prev() (val T, err error) {
+	if err = it.check(); err != nil {
+		return val, err
+	}
+	prev := it.l.l.Back()
+	if it.next != nil {
+		prev = it.next.Prev()
+	}
+	if prev == nil {
+		return val, container.ErrNoSuchElement
+	}
+	it.next, it.last = prev, prev
+	it.index--
+	return value[T](prev), nil
+}
+
+// NextIndex implement container.ListIterator.
+func (it *listIterator[T]) NextIndex() int { return it.index }
+
+// PrevIndex implement container.ListIterator.
+func (it *listIterator[T]) PrevIndex() int { return it.index - 1 }
+
+// Set implement container.ListIterator.
+func (it *listIterator[T]) Set(v T) error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last == nil {
+		return container.ErrIllegalState
+	}
+	it.last.Value = v
+	return nil
+}
+
+// Remove implement container.ListIterator.
+func (it *listIterator[T]) Remove() error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last == nil {
+		return container.ErrIllegalState
+	}
+	if it.last == it.next { // returned by Prev
+		it.next = it.last.Next()
+	} else { // returned by Next
+		it.index--
+	}
+	it.l.l.Remove(it.last)
+	it.l.modCount++
+	it.last = nil
+	it.modCount = it.l.modCount
+	return nil
+}
+
+// InsertBefore implement container.ListIterator.
+func (it *listIterator[T]) InsertBefore(v T) error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.next == nil {
+		it.l.l.PushBack(v)
+	} else {
+		it.l.l.InsertBefore(v, it.next)
+	}
+	it.index++
+	it.inserted()
+	return nil
+}
+
+// InsertAfter implement container.ListIterator.
+func (it *listIterator[T]) InsertAfter(v T) error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.next == nil {
+		it.next = it.l.l.PushBack(v)
+	} else {
+		it.next = it.l.l.InsertBefore(v, it.next)
+	}
+	it.inserted()
+	return nil
+}
+
+func (it *listIterator[T]) inserted() {
+	it.l.modCount++
+	it.last = nil
+	it.modCount = it.l.modCount
+}
+
+func (it *listIterator[T]) check() error {
+	if it.modCount != it.l.modCount {
+		return container.ErrConcurrentModification
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linkedlist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+)
+
+func TestListIterator(t *testing.T) {
+	l := NewOf[int]()
+	for i := 1; i <= 6; i++ {
+		l.PushBack(i)
+	}
+
+	// remove the even ones, double the odd ones and insert 0 after 3
+	it := l.ListIterator()
+	assert.False(t, it.HasPrev())
+	assert.Equal(t, -1, it.PrevIndex())
+	assert.ErrorIs(t, it.Set(0), container.ErrIllegalState)
+	assert.ErrorIs(t, it.Remove(), container.ErrIllegalState)
+	_, err := it.Prev()
+	assert.ErrorIs(t, err, container.ErrNoSuchElement)
+	for it.HasNext() {
+		v, err := it.Next()
+		require.NoError(t, err)
+		if v%2 == 0 {
+			require.NoError(t, it.Remove())
+			assert.ErrorIs(t, it.Remove(), container.ErrIllegalState)
+			continue
+		}
+		require.NoError(t, it.Set(v*2))
+		if v == 3 {
+			require.NoError(t, it.InsertBefore(0))
+		}
+	}
+	_, err = it.Next()
+	assert.ErrorIs(t, err, container.ErrNoSuchElement)
+	assert.Equal(t, []int{2, 6, 0, 10}, l.Values())
+	assert.Equal(t, 4, it.NextIndex())
+
+	// walk backward and insert after the cursor
+	var got []int
+	for it.HasPrev() {
+		v, err := it.Prev()
+		require.NoError(t, err)
+		got = append(got, v)
+		if v == 0 {
+			require.NoError(t, it.Remove())
+			require.NoError(t, it.InsertAfter(-1))
+			v, err = it.Next()
+			require.NoError(t, err)
+			assert.Equal(t, -1, v)
+			_, err = it.Prev()
+			require.NoError(t, err)
+		}
+	}
+	assert.Equal(t, []int{10, 0, 6, 2}, got)
+	assert.Equal(t, []int{2, 6, -1, 10}, l.Values())
+	assert.Equal(t, 0, it.NextIndex())
+
+	// start from the index
+	it, err = l.ListIteratorAt(l.Len())
+	require.NoError(t, err)
+	assert.False(t, it.HasNext())
+	v, err := it.Prev()
+	require.NoError(t, err)
+	assert.Equal(t, 10, v)
+	_, err = l.ListIteratorAt(l.Len() + 1)
+	assert.Error(t, err)
+	_, err = l.ListIteratorAt(-1)
+	assert.Error(t, err)
+}
+
+func TestListIteratorConcurrentModification(t *testing.T) {
+	l := New()
+	l.PushBack(1)
+	l.PushBack(2)
+
+	it1 := l.ListIterator()
+	it2 := l.ListIterator()
+	_, err := it1.Next()
+	require.NoError(t, err)
+	require.NoError(t, it1.Remove())
+
+	_, err = it2.Next()
+	assert.ErrorIs(t, err, container.ErrConcurrentModification)
+	assert.ErrorIs(t, it2.InsertAfter(3), container.ErrConcurrentModification)
+
+	// Set is not a structural modification
+	it3 := l.ListIterator()
+	_, err = it1.Next()
+	require.NoError(t, err)
+	require.NoError(t, it1.Set(4))
+	_, err = it3.Next()
+	assert.NoError(t, err)
+
+	l.Push(5)
+	_, err = it1.Prev()
+	assert.ErrorIs(t, err, container.ErrConcurrentModification)
+	assert.ErrorIs(t, it1.Set(6), container.ErrConcurrentModification)
+	assert.ErrorIs(t, it1.Remove(), container.ErrConcurrentModification)
+	assert.ErrorIs(t, it1.InsertBefore(6), container.ErrConcurrentModification)
+	assert.Equal(t, []interface{}{4, 5}, l.Values())
+}
@@ -18,60 +18,93 @@ package linkedlist
 import (
 	"container/list"
 	"fmt"
+	"iter"
 
 	"github.com/thinkgos/container"
 	"github.com/thinkgos/container/comparator"
 )
 
-var _ container.List = (*LinkedList)(nil)
+var _ container.ExtendedList = (*LinkedList)(nil)
 
-// LinkedList represents a doubly linked list.
+// LinkedList represents a doubly linked list of interface{} elements.
 // It implements the interface list.Interface.
-type LinkedList struct {
+type LinkedList = LinkedListOf[interface{}]
+
+// LinkedListOf represents a doubly linked list of T elements.
+// It implements the interface list.Interface.
+type LinkedListOf[T any] struct {
 	l   *list.List
+	cmp comparator.CompareFunc[T]
+	// modCount counts the structural modifications, the list iterators use it to fail fast.
+	modCount int
+}
+
+type options struct {
 	cmp comparator.Comparator
 }
 
 // Option option for New.
-type Option func(l *LinkedList)
+type Option func(o *options)
 
 // WithComparator with user's Comparator.
 func WithComparator(cmp comparator.Comparator) Option {
-	return func(l *LinkedList) {
-		l.cmp = cmp
+	return func(o *options) {
+		o.cmp = cmp
 	}
 }
 
+// WithCompareFunc with user's CompareFunc of T elements.
+func WithCompareFunc[T any](f comparator.CompareFunc[T]) Option {
+	return WithComparator(f)
+}
+
 // New initializes and returns an LinkedList.
 func New(opts ...Option) *LinkedList {
-	l := &LinkedList{l: list.New()}
+	return NewOf[interface{}](opts...)
+}
+
+// NewOf initializes and returns an LinkedList of T elements.
+func NewOf[T any](opts ...Option) *LinkedListOf[T] {
+	o := options{}
 	for _, opt := range opts {
-		opt(l)
+		opt(&o)
+	}
+	return &LinkedListOf[T]{
+		l:   list.New(),
+		cmp: comparator.FuncOf[T](o.cmp),
 	}
-	return l
 }
 
 // Len returns the number of elements of list l.
 // The complexity is O(1).
-func (sf *LinkedList) Len() int { return sf.l.Len() }
+func (sf *LinkedListOf[T]) Len() int { return sf.l.Len() }
 
 // IsEmpty returns the list l is empty or not.
-func (sf *LinkedList) IsEmpty() bool { return sf.l.Len() == 0 }
+func (sf *LinkedListOf[T]) IsEmpty() bool { return sf.l.Len() == 0 }
 
 // Clear initializes or clears list l.
-func (sf *LinkedList) Clear() { sf.l.Init() }
+func (sf *LinkedListOf[T]) Clear() {
+	sf.l.Init()
+	sf.modCount++
+}
 
 // Push inserts a new element e with value v at the back of list l.
-func (sf *LinkedList) Push(v interface{}) { sf.l.PushBack(v) }
+func (sf *LinkedListOf[T]) Push(v T) { sf.PushBack(v) }
 
 // PushFront inserts a new element e with value v at the front of list l.
-func (sf *LinkedList) PushFront(v interface{}) { sf.l.PushFront(v) }
+func (sf *LinkedListOf[T]) PushFront(v T) {
+	sf.l.PushFront(v)
+	sf.modCount++
+}
 
 // PushBack inserts a new element e with value v at the back of list l.
-func (sf *LinkedList) PushBack(v interface{}) { sf.l.PushBack(v) }
+func (sf *LinkedListOf[T]) PushBack(v T) {
+	sf.l.PushBack(v)
+	sf.modCount++
+}
 
 // Add add to the index of the list with value.
-func (sf *LinkedList) Add(index int, val interface{}) error {
+func (sf *LinkedListOf[T]) Add(index int, val T) error {
 	if index < 0 || index > sf.Len() {
 		return fmt.Errorf("index out of range, index: %d, len: %d", index, sf.Len())
 	}
@@ -81,61 +114,69 @@ func (sf *LinkedList) Add(index int, val interface{}) error {
 	} else {
 		sf.l.InsertBefore(val, sf.getElement(index))
 	}
+	sf.modCount++
 	return nil
 }
 
 // PushFrontList inserts a copy of an other list at the front of list l.
 // The lists l and other may be the same. They must not be nil.
-func (sf *LinkedList) PushFrontList(other *LinkedList) {
+func (sf *LinkedListOf[T]) PushFrontList(other *LinkedListOf[T]) {
 	sf.l.PushFrontList(other.l)
+	sf.modCount++
 }
 
 // PushBackList inserts a copy of an other list at the back of list l.
 // The lists l and other may be the same. They must not be nil.
-func (sf *LinkedList) PushBackList(other *LinkedList) {
+func (sf *LinkedListOf[T]) PushBackList(other *LinkedListOf[T]) {
 	sf.l.PushBackList(other.l)
+	sf.modCount++
 }
 
 // Poll return the front element value and then remove from list.
-func (sf *LinkedList) Poll() interface{} {
+func (sf *LinkedListOf[T]) Poll() T {
 	return sf.PollFront()
 }
 
 // PollFront return the front element value and then remove from list.
-func (sf *LinkedList) PollFront() interface{} {
+func (sf *LinkedListOf[T]) PollFront() (val T) {
 	e := sf.l.Front()
 	if e != nil {
-		return sf.l.Remove(e)
+		val, _ = sf.l.Remove(e).(T)
+		sf.modCount++
 	}
-	return nil
+	return val
 }
 
 // PollBack return the back element value and then remove from list.
-func (sf *LinkedList) PollBack() interface{} {
+func (sf *LinkedListOf[T]) PollBack() (val T) {
 	e := sf.l.Back()
 	if e != nil {
-		return sf.l.Remove(e)
+		val, _ = sf.l.Remove(e).(T)
+		sf.modCount++
 	}
-	return nil
+	return val
 }
 
 // Remove remove the index in the list.
-func (sf *LinkedList) Remove(index int) (interface{}, error) {
+func (sf *LinkedListOf[T]) Remove(index int) (val T, err error) {
 	if index < 0 || index >= sf.Len() {
-		return nil, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
+		return val, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
 	}
-	return sf.l.Remove(sf.getElement(index)), nil
+	val, _ = sf.l.Remove(sf.getElement(index)).(T)
+	sf.modCount++
+	return val, nil
 }
 
 // RemoveValue remove the value in the list.
-func (sf *LinkedList) RemoveValue(val interface{}) bool {
+func (sf *LinkedListOf[T]) RemoveValue(val T) bool {
 	if sf.Len() == 0 {
 		return false
 	}
 
 	for e := sf.l.Front(); e != nil; e = e.Next() {
-		if sf.compare(val, e.Value) {
+		if sf.compare(val, value[T](e)) {
 			sf.l.Remove(e)
+			sf.modCount++
 			return true
 		}
 	}
@@ -143,82 +184,141 @@ func (sf *LinkedList) RemoveValue(val interface{}) bool {
 }
 
 // Get get the index in the list.
-func (sf *LinkedList) Get(index int) (interface{}, error) {
+func (sf *LinkedListOf[T]) Get(index int) (val T, err error) {
 	if index < 0 || index >= sf.Len() {
-		return nil, fmt.Errorf("index out of range, index: %d, len: %d", index, sf.Len())
+		return val, fmt.Errorf("index out of range, index: %d, len: %d", index, sf.Len())
 	}
-	return sf.getElement(index).Value, nil
+	return value[T](sf.getElement(index)), nil
 }
 
 // Peek return the front element value.
-func (sf *LinkedList) Peek() interface{} {
+func (sf *LinkedListOf[T]) Peek() T {
 	return sf.PeekFront()
 }
 
 // PeekFront return the front element value.
-func (sf *LinkedList) PeekFront() interface{} {
+func (sf *LinkedListOf[T]) PeekFront() (val T) {
 	if e := sf.l.Front(); e != nil {
-		return e.Value
+		return value[T](e)
 	}
-	return nil
+	return val
 }
 
 // PeekBack return the back element value.
-func (sf *LinkedList) PeekBack() interface{} {
+func (sf *LinkedListOf[T]) PeekBack() (val T) {
 	if e := sf.l.Back(); e != nil {
-		return e.Value
+		return value[T](e)
 	}
-	return nil
+	return val
 }
 
 // Iterator iterator the list.
-func (sf *LinkedList) Iterator(cb func(interface{}) bool) {
+func (sf *LinkedListOf[T]) Iterator(cb func(T) bool) {
 	for e := sf.l.Front(); e != nil; e = e.Next() {
-		if cb == nil || !cb(e.Value) {
+		if cb == nil || !cb(value[T](e)) {
 			return
 		}
 	}
 }
 
 // ReverseIterator reverse iterator the list.
-func (sf *LinkedList) ReverseIterator(cb func(interface{}) bool) {
+func (sf *LinkedListOf[T]) ReverseIterator(cb func(T) bool) {
 	for e := sf.l.Back(); e != nil; e = e.Prev() {
-		if cb == nil || !cb(e.Value) {
+		if cb == nil || !cb(value[T](e)) {
 			return
 		}
 	}
 }
 
+// All returns an iterator over the elements in this list in proper sequence.
+func (sf *LinkedListOf[T]) All() iter.Seq[T] { return sf.Iterator }
+
+// Backward returns an iterator over the elements in this list in reverse sequence.
+func (sf *LinkedListOf[T]) Backward() iter.Seq[T] { return sf.ReverseIterator }
+
 // Contains contains the value.
-func (sf *LinkedList) Contains(val interface{}) bool {
-	return val != nil && sf.indexOf(val) >= 0
+func (sf *LinkedListOf[T]) Contains(val T) bool {
+	return any(val) != nil && sf.indexOf(val) >= 0
 }
 
-// Sort sort the list.
-func (sf *LinkedList) Sort(reverse ...bool) {
+// Sort sorts the list by a stable bottom-up merge sort on the linked elements.
+// It takes O(n log n) time and O(1) extra memory, the elements are relinked in place, not copied.
+func (sf *LinkedListOf[T]) Sort(reverse ...bool) {
 	if sf.Len() <= 1 {
 		return
 	}
 
-	// get all the Values and sort the data
-	vs := sf.Values()
-	comparator.Sort(vs, sf.cmp, reverse...)
+	less := sf.lessFunc(reverse...)
+	for width := 1; width < sf.Len(); width *= 2 {
+		for a := sf.l.Front(); a != nil; {
+			b := advance(a, width)
+			if b == nil {
+				break
+			}
+			a = sf.mergeRuns(a, width, b, width, less)
+		}
+	}
+	sf.modCount++
+}
+
+// Merge merges the other sorted list into this sorted list, keeping the result sorted and stable,
+// the elements of this list go before the equal elements of other.
+// The values of other are copied into this list, since the elements can't move between lists,
+// then other becomes empty. Both lists must be sorted in the same order.
+func (sf *LinkedListOf[T]) Merge(other *LinkedListOf[T], reverse ...bool) {
+	if other == nil || other == sf || other.Len() == 0 {
+		return
+	}
+	less := sf.lessFunc(reverse...)
+	e := sf.l.Front()
+	for o := other.l.Front(); o != nil; o = o.Next() {
+		v := value[T](o)
+		for e != nil && !less(v, value[T](e)) {
+			e = e.Next()
+		}
+		if e == nil {
+			sf.l.PushBack(v)
+		} else {
+			sf.l.InsertBefore(v, e)
+		}
+	}
+	sf.modCount++
+	other.Clear()
+}
+
+// mergeRuns merges the adjacent sorted runs starting from a with at most la elements
+// and starting from b with at most lb elements, it returns the element next to the merged run.
+func (sf *LinkedListOf[T]) mergeRuns(a *list.Element, la int, b *list.Element, lb int, less func(v1, v2 T) bool) *list.Element {
+	for la > 0 && lb > 0 && b != nil {
+		if less(value[T](b), value[T](a)) {
+			next := b.Next()
+			sf.l.MoveBefore(b, a)
+			b = next
+			lb--
+		} else {
+			a = a.Next()
+			la--
+		}
+	}
+	return advance(b, lb)
+}
 
-	// clear the linked list and push it back
-	sf.Clear()
-	for i := 0; i < len(vs); i++ {
-		sf.PushBack(vs[i])
+func (sf *LinkedListOf[T]) lessFunc(reverse ...bool) func(v1, v2 T) bool {
+	cmp := comparator.OrderOf(sf.cmp)
+	if len(reverse) > 0 && reverse[0] {
+		return func(v1, v2 T) bool { return cmp(v2, v1) < 0 }
 	}
+	return func(v1, v2 T) bool { return cmp(v1, v2) < 0 }
 }
 
 // Values get a copy of all the values in the list.
-func (sf *LinkedList) Values() []interface{} {
+func (sf *LinkedListOf[T]) Values() []T {
 	if sf.Len() == 0 {
-		return []interface{}{}
+		return []T{}
 	}
 
-	values := make([]interface{}, 0, sf.Len())
-	sf.Iterator(func(v interface{}) bool {
+	values := make([]T, 0, sf.Len())
+	sf.Iterator(func(v T) bool {
 		values = append(values, v)
 		return true
 	})
@@ -226,7 +326,7 @@ func (sf *LinkedList) Values() []interface{} {
 }
 
 // getElement returns the element at the specified position.
-func (sf *LinkedList) getElement(index int) *list.Element {
+func (sf *LinkedListOf[T]) getElement(index int) *list.Element {
 	var e *list.Element
 
 	if i, length := 0, sf.Len(); index < (length >> 1) {
@@ -243,9 +343,9 @@ func (sf *LinkedList) getElement(index int) *list.Element {
 
 // indexOf returns the index of the first occurrence of the specified element
 // in this list, or -1 if this list does not contain the element.
-func (sf *LinkedList) indexOf(val interface{}) int {
+func (sf *LinkedListOf[T]) indexOf(val T) int {
 	for index, e := 0, sf.l.Front(); e != nil; e = e.Next() {
-		if sf.compare(val, e.Value) {
+		if sf.compare(val, value[T](e)) {
 			return index
 		}
 		index++
@@ -253,9 +353,23 @@ func (sf *LinkedList) indexOf(val interface{}) int {
 	return -1
 }
 
-func (sf *LinkedList) compare(v1, v2 interface{}) bool {
+func (sf *LinkedListOf[T]) compare(v1, v2 T) bool {
 	if sf.cmp != nil {
-		return sf.cmp.Compare(v1, v2) == 0
+		return sf.cmp(v1, v2) == 0
+	}
+	return any(v1) == any(v2)
+}
+
+// advance returns the element n steps after e, or nil if the list ends before.
+func advance(e *list.Element, n int) *list.Element {
+	for ; e != nil && n > 0; n-- {
+		e = e.Next()
 	}
-	return v1 == v2
+	return e
+}
+
+// value returns the value of the element as T.
+func value[T any](e *list.Element) T {
+	v, _ := e.Value.(T)
+	return v
 }
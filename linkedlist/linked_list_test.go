@@ -1,10 +1,15 @@
 package linkedlist
 
 import (
+	"container/list"
+	"math/rand"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
 )
 
 func checkList(t *testing.T, l *LinkedList, es []interface{}) {
@@ -274,3 +279,167 @@ func (aln *linkedListNode) Compare(v1, v2 interface{}) int {
 
 	return 1
 }
+
+func TestLinkedListOf(t *testing.T) {
+	var l container.ListOf[int] = NewOf[int]()
+	l.PushBack(5)
+	l.PushBack(7)
+	l.PushFront(6)
+	require.NoError(t, l.Add(1, 9))
+
+	assert.Equal(t, []int{6, 9, 5, 7}, l.Values())
+	assert.True(t, l.Contains(9))
+	assert.True(t, l.RemoveValue(9))
+	assert.False(t, l.Contains(9))
+
+	l.Sort()
+	assert.Equal(t, []int{5, 6, 7}, l.Values())
+	l.Sort(true)
+	assert.Equal(t, []int{7, 6, 5}, l.Values())
+
+	v, err := l.Get(10)
+	assert.Error(t, err)
+	assert.Zero(t, v)
+	assert.Equal(t, 7, l.PollFront())
+	assert.Equal(t, 5, l.PollBack())
+	assert.Equal(t, 6, l.Poll())
+	assert.Zero(t, l.Poll())
+	assert.Zero(t, l.PeekBack())
+
+	// with compare function
+	sl := NewOf[string](WithCompareFunc(func(s1, s2 string) int { return len(s1) - len(s2) }))
+	sl.Push("ccc")
+	sl.Push("a")
+	sl.Push("bb")
+	assert.True(t, sl.Contains("zz"))
+	sl.Sort()
+	assert.Equal(t, []string{"a", "bb", "ccc"}, sl.Values())
+
+	// nil value of interface{} element
+	il := New()
+	il.Push(nil)
+	assert.Nil(t, il.PeekFront())
+	assert.Nil(t, il.PollFront())
+}
+
+func TestLinkedListAll(t *testing.T) {
+	l := NewOf[int]()
+	for i := 1; i <= 5; i++ {
+		l.PushBack(i)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(l.All()))
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, slices.Collect(l.Backward()))
+
+	var got []int
+	for v := range l.Backward() {
+		if v < 3 {
+			break
+		}
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{5, 4, 3}, got)
+	assert.Empty(t, slices.Collect(NewOf[int]().All()))
+}
+
+func TestLinkedListSortStable(t *testing.T) {
+	type pair struct{ key, seq int }
+	byKey := WithCompareFunc(func(p1, p2 pair) int { return p1.key - p2.key })
+
+	for _, n := range []int{0, 1, 2, 3, 7, 16, 100, 1001} {
+		for _, rev := range []bool{false, true} {
+			l := NewOf[pair](byKey)
+			want := make([]pair, 0, n)
+			for i := 0; i < n; i++ {
+				p := pair{rand.Intn(10), i}
+				l.PushBack(p)
+				want = append(want, p)
+			}
+			elements := make(map[*list.Element]struct{}, n)
+			for e := l.l.Front(); e != nil; e = e.Next() {
+				elements[e] = struct{}{}
+			}
+
+			l.Sort(rev)
+			if rev {
+				slices.SortStableFunc(want, func(p1, p2 pair) int { return p2.key - p1.key })
+			} else {
+				slices.SortStableFunc(want, func(p1, p2 pair) int { return p1.key - p2.key })
+			}
+			require.Equal(t, want, l.Values(), "n: %d, reverse: %v", n, rev)
+			require.Equal(t, n, l.Len())
+			// the elements keep their identities
+			for e := l.l.Front(); e != nil; e = e.Next() {
+				_, ok := elements[e]
+				require.True(t, ok)
+			}
+		}
+	}
+}
+
+func TestLinkedListMerge(t *testing.T) {
+	type pair struct {
+		key  int
+		name string
+	}
+	byKey := WithCompareFunc(func(p1, p2 pair) int { return p1.key - p2.key })
+	l1 := NewOf[pair](byKey)
+	l2 := NewOf[pair](byKey)
+	for _, p := range []pair{{1, "a"}, {3, "a"}, {3, "b"}, {7, "a"}} {
+		l1.PushBack(p)
+	}
+	for _, p := range []pair{{0, "c"}, {3, "c"}, {5, "c"}, {8, "c"}, {9, "c"}} {
+		l2.PushBack(p)
+	}
+	l1.Merge(l2)
+	l1.Merge(l1)
+	l1.Merge(nil)
+	assert.True(t, l2.IsEmpty())
+	assert.Equal(t, []pair{{0, "c"}, {1, "a"}, {3, "a"}, {3, "b"}, {3, "c"}, {5, "c"}, {7, "a"}, {8, "c"}, {9, "c"}}, l1.Values())
+
+	l3 := NewOf[int]()
+	l4 := NewOf[int]()
+	for _, v := range []int{9, 5, 1} {
+		l3.PushBack(v)
+	}
+	for _, v := range []int{8, 6, 2, 0} {
+		l4.PushBack(v)
+	}
+	l3.Merge(l4, true)
+	assert.Equal(t, []int{9, 8, 6, 5, 2, 1, 0}, l3.Values())
+
+	// merge into an empty list
+	l5 := NewOf[int]()
+	l5.Merge(l3, true)
+	assert.Equal(t, []int{9, 8, 6, 5, 2, 1, 0}, l5.Values())
+	assert.True(t, l3.IsEmpty())
+}
+
+func TestLinkedListSortNamed(t *testing.T) {
+	type level int
+	l := NewOf[level]()
+	for _, v := range []level{3, 1, 2} {
+		l.PushBack(v)
+	}
+	l.Sort()
+	assert.Equal(t, []level{1, 2, 3}, l.Values())
+
+	// a struct has no natural ordering, it needs a comparator to sort
+	s := NewOf[struct{ v int }]()
+	s.PushBack(struct{ v int }{2})
+	s.PushBack(struct{ v int }{1})
+	assert.Panics(t, func() { s.Sort() })
+}
+
+func BenchmarkLinkedListSort(b *testing.B) {
+	values := rand.Perm(10000)
+	l := NewOf[int]()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		l.Clear()
+		for _, v := range values {
+			l.PushBack(v)
+		}
+		b.StartTimer()
+		l.Sort()
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linkedmap
+
+import (
+	"container/list"
+	"encoding/json"
+
+	"github.com/thinkgos/container"
+)
+
+type jsonEntry[K comparable, V any] struct {
+	Key   container.JSONValue[K] `json:"key"`
+	Value container.JSONValue[V] `json:"value"`
+}
+
+type jsonMap[K comparable, V any] struct {
+	Capacity int               `json:"capacity"`
+	Entries  []jsonEntry[K, V] `json:"entries"`
+}
+
+type gobMap[K comparable, V any] struct {
+	Capacity int
+	Keys     []K
+	Values   []V
+}
+
+// MarshalJSON implement json.Marshaler, the map is encoded as
+// {"capacity": capacity, "entries": [{"key": key, "value": value}]} in insertion order.
+// The keys and values of interface type are encoded as container.JSONValue.
+func (sf *LinkedMapOf[K, V]) MarshalJSON() ([]byte, error) {
+	m := jsonMap[K, V]{Capacity: sf.capacity, Entries: make([]jsonEntry[K, V], 0, sf.length())}
+	if sf.ll != nil {
+		for k, v := range sf.All() {
+			m.Entries = append(m.Entries, jsonEntry[K, V]{container.JSONValue[K]{V: k}, container.JSONValue[V]{V: v}})
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON implement json.Unmarshaler, it replaces the capacity and the entries of this map,
+// the comparator is kept.
+func (sf *LinkedMapOf[K, V]) UnmarshalJSON(data []byte) error {
+	var m jsonMap[K, V]
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	sf.reset(m.Capacity)
+	for _, e := range m.Entries {
+		sf.PushBack(e.Key.V, e.Value.V)
+	}
+	return nil
+}
+
+// MarshalBinary implement encoding.BinaryMarshaler, the capacity and the entries are encoded by gob in insertion order.
+func (sf *LinkedMapOf[K, V]) MarshalBinary() ([]byte, error) {
+	m := gobMap[K, V]{Capacity: sf.capacity}
+	if sf.ll != nil {
+		for k, v := range sf.All() {
+			m.Keys = append(m.Keys, k)
+			m.Values = append(m.Values, v)
+		}
+	}
+	return container.GobEncode(m)
+}
+
+// UnmarshalBinary implement encoding.BinaryUnmarshaler, it replaces the capacity and the entries of this map,
+// the comparator is kept.
+func (sf *LinkedMapOf[K, V]) UnmarshalBinary(data []byte) error {
+	var m gobMap[K, V]
+	if err := container.GobDecode(data, &m); err != nil {
+		return err
+	}
+	sf.reset(m.Capacity)
+	for i, k := range m.Keys {
+		var v V
+		if i < len(m.Values) {
+			v = m.Values[i]
+		}
+		sf.PushBack(k, v)
+	}
+	return nil
+}
+
+// GobEncode implement gob.GobEncoder.
+func (sf *LinkedMapOf[K, V]) GobEncode() ([]byte, error) { return sf.MarshalBinary() }
+
+// GobDecode implement gob.GobDecoder.
+func (sf *LinkedMapOf[K, V]) GobDecode(data []byte) error { return sf.UnmarshalBinary(data) }
+
+func (sf *LinkedMapOf[K, V]) length() int {
+	if sf.ll == nil {
+		return 0
+	}
+	return sf.ll.Len()
+}
+
+// reset clears the map and sets the capacity, it initializes the map if it is the zero value.
+func (sf *LinkedMapOf[K, V]) reset(capacity int) {
+	if sf.ll == nil {
+		sf.ll = list.New()
+	}
+	sf.Clear()
+	sf.capacity = capacity
+}
@@ -0,0 +1,70 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linkedmap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkedMapCodec(t *testing.T) {
+	m := New(WithCap(4))
+	m.Push(1, "one")
+	m.Push("two", 2)
+	m.Push(int64(3), nil)
+	m.Push(1, 1.5) // move to back
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	got := New()
+	got.Push("replaced", true)
+	require.NoError(t, json.Unmarshal(data, got))
+	assert.Equal(t, 4, got.Cap())
+	assert.Equal(t, slices.Collect(m.Keys()), slices.Collect(got.Keys()))
+	assert.Equal(t, slices.Collect(m.Values()), slices.Collect(got.Values()))
+
+	data, err = m.MarshalBinary()
+	require.NoError(t, err)
+	got = New()
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, 4, got.Cap())
+	assert.Equal(t, slices.Collect(m.Keys()), slices.Collect(got.Keys()))
+	assert.Equal(t, slices.Collect(m.Values()), slices.Collect(got.Values()))
+
+	// gob with the zero value
+	ms := NewOf[string, int]()
+	ms.Push("c", 3)
+	ms.Push("a", 1)
+	ms.Push("b", 2)
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(ms))
+	var gotStr LinkedMapOf[string, int]
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&gotStr))
+	assert.Equal(t, []string{"c", "a", "b"}, slices.Collect(gotStr.Keys()))
+	assert.Equal(t, 2, gotStr.Get("b"))
+
+	data, err = json.Marshal(ms)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"capacity":0,"entries":[{"key":"c","value":3},{"key":"a","value":1},{"key":"b","value":2}]}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`[]`), got))
+	assert.Error(t, got.UnmarshalBinary([]byte("bad")))
+}
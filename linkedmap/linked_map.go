@@ -16,6 +16,7 @@ package linkedmap
 
 import (
 	"container/list"
+	"iter"
 
 	"github.com/thinkgos/container"
 	"github.com/thinkgos/container/comparator"
@@ -23,92 +24,113 @@ import (
 
 var _ container.LinkedMap = (*LinkedMap)(nil)
 
-type store struct {
-	key, value interface{}
+type store[K comparable, V any] struct {
+	key   K
+	value V
 }
 
-// LinkedMap implements the Interface.
-type LinkedMap struct {
-	data     map[interface{}]*list.Element
+// LinkedMap implements the Interface of interface{} keys and values.
+type LinkedMap = LinkedMapOf[interface{}, interface{}]
+
+// LinkedMapOf implements the Interface of K keys and V values.
+type LinkedMapOf[K comparable, V any] struct {
+	data     map[K]*list.Element
 	ll       *list.List
+	cmp      comparator.CompareFunc[V]
+	capacity int
+}
+
+type options struct {
 	cmp      comparator.Comparator
 	capacity int
 }
 
 // Option option for New.
-type Option func(lm *LinkedMap)
+type Option func(o *options)
 
 // WithCap with limit capacity.
 func WithCap(capacity int) Option {
-	return func(lm *LinkedMap) {
-		lm.capacity = capacity
+	return func(o *options) {
+		o.capacity = capacity
 	}
 }
 
-// WithComparator with user's Comparator.
+// WithComparator with user's Comparator for the values.
 func WithComparator(cmp comparator.Comparator) Option {
-	return func(lm *LinkedMap) {
-		lm.cmp = cmp
+	return func(o *options) {
+		o.cmp = cmp
 	}
 }
 
+// WithCompareFunc with user's CompareFunc of V values.
+func WithCompareFunc[V any](f comparator.CompareFunc[V]) Option {
+	return WithComparator(f)
+}
+
 // New creates a LinkedMap.
 func New(opts ...Option) *LinkedMap {
-	lm := &LinkedMap{
-		data: make(map[interface{}]*list.Element),
-		ll:   list.New(),
-	}
+	return NewOf[interface{}, interface{}](opts...)
+}
+
+// NewOf creates a LinkedMapOf of K keys and V values.
+func NewOf[K comparable, V any](opts ...Option) *LinkedMapOf[K, V] {
+	o := options{}
 	for _, opt := range opts {
-		opt(lm)
+		opt(&o)
+	}
+	return &LinkedMapOf[K, V]{
+		data:     make(map[K]*list.Element),
+		ll:       list.New(),
+		cmp:      comparator.FuncOf[V](o.cmp),
+		capacity: o.capacity,
 	}
-	return lm
 }
 
 // Cap returns the capacity of elements of list ll.
 // The complexity is O(1).
-func (sf *LinkedMap) Cap() int { return sf.capacity }
+func (sf *LinkedMapOf[K, V]) Cap() int { return sf.capacity }
 
 // Len returns the number of elements of list ll.
 // The complexity is O(1).
-func (sf *LinkedMap) Len() int { return sf.ll.Len() }
+func (sf *LinkedMapOf[K, V]) Len() int { return sf.ll.Len() }
 
 // IsEmpty returns the list ll is empty or not.
-func (sf *LinkedMap) IsEmpty() bool { return sf.Len() == 0 }
+func (sf *LinkedMapOf[K, V]) IsEmpty() bool { return sf.Len() == 0 }
 
 // Clear initializes or clears list ll.
-func (sf *LinkedMap) Clear() {
-	sf.data = make(map[interface{}]*list.Element)
+func (sf *LinkedMapOf[K, V]) Clear() {
+	sf.data = make(map[K]*list.Element)
 	sf.ll.Init()
 }
 
 // Push associates the specified value with the specified key in this map.
 // If the map previously contained a mapping for the key,
 // the old value is replaced by the specified value. and then move the item to the back of the list.
-// If over the capacity, it will remove the back item then push new item to back
-// It returns the previous value associated with the specified key, or nil if there was no mapping for the key.
-// A nil return can also indicate that the map previously associated nil with the specified key.
-func (sf *LinkedMap) Push(k, v interface{}) interface{} { return sf.PushBack(k, v) }
+// If over the capacity, it will remove the front item then push new item to back
+// It returns the previous value associated with the specified key, or the zero value if there was no mapping for the key.
+// A zero value return can also indicate that the map previously associated the zero value with the specified key.
+func (sf *LinkedMapOf[K, V]) Push(k K, v V) V { return sf.PushBack(k, v) }
 
 // PushFront associates the specified value with the specified key in this map.
 // If the map previously contained a mapping for the key,
 // the old value is replaced by the specified value. and then move the item to the front of the list.
 // If over the capacity, it will remove the back item then push new item to front
-// It returns the previous value associated with the specified key, or nil if there was no mapping for the key.
-// A nil return can also indicate that the map previously associated nil with the specified key.
-func (sf *LinkedMap) PushFront(k, v interface{}) interface{} {
-	var retVal interface{}
+// It returns the previous value associated with the specified key, or the zero value if there was no mapping for the key.
+// A zero value return can also indicate that the map previously associated the zero value with the specified key.
+func (sf *LinkedMapOf[K, V]) PushFront(k K, v V) V {
+	var retVal V
 
 	if old, ok := sf.data[k]; ok {
-		retVal = old.Value.(*store).value
-		old.Value = &store{k, v}
+		retVal = old.Value.(*store[K, V]).value
+		old.Value = &store[K, V]{k, v}
 		sf.ll.MoveToFront(old)
 	} else {
 		if sf.capacity != 0 && sf.ll.Len() >= sf.capacity {
 			e := sf.ll.Back()
-			delete(sf.data, e.Value.(*store).key)
+			delete(sf.data, e.Value.(*store[K, V]).key)
 			sf.ll.Remove(e)
 		}
-		sf.data[k] = sf.ll.PushFront(&store{k, v})
+		sf.data[k] = sf.ll.PushFront(&store[K, V]{k, v})
 	}
 	return retVal
 }
@@ -116,118 +138,120 @@ func (sf *LinkedMap) PushFront(k, v interface{}) interface{} {
 // PushBack associates the specified value with the specified key in this map.
 // If the map previously contained a mapping for the key,
 // the old value is replaced by the specified value. and then move the item to the back of the list.
-// If over the capacity, it will remove the back item then push new item to back.
-func (sf *LinkedMap) PushBack(k, v interface{}) interface{} {
-	var retVal interface{}
+// If over the capacity, it will remove the front item then push new item to back.
+func (sf *LinkedMapOf[K, V]) PushBack(k K, v V) V {
+	var retVal V
 
 	if old, ok := sf.data[k]; ok {
-		retVal = old.Value.(*store).value
-		old.Value = &store{k, v}
+		retVal = old.Value.(*store[K, V]).value
+		old.Value = &store[K, V]{k, v}
 		sf.ll.MoveToBack(old)
 	} else {
 		if sf.capacity != 0 && sf.ll.Len() >= sf.capacity {
 			e := sf.ll.Front()
-			delete(sf.data, e.Value.(*store).key)
+			delete(sf.data, e.Value.(*store[K, V]).key)
 			sf.ll.Remove(e)
 		}
-		sf.data[k] = sf.ll.PushBack(&store{k, v})
+		sf.data[k] = sf.ll.PushBack(&store[K, V]{k, v})
 	}
 	return retVal
 }
 
 // Poll return the front element value and then remove from list.
-func (sf *LinkedMap) Poll() (k, v interface{}, exist bool) { return sf.PollFront() }
+func (sf *LinkedMapOf[K, V]) Poll() (k K, v V, exist bool) { return sf.PollFront() }
 
 // PollFront return the front element value and then remove from list.
-func (sf *LinkedMap) PollFront() (k, v interface{}, exist bool) {
+func (sf *LinkedMapOf[K, V]) PollFront() (k K, v V, exist bool) {
 	if e := sf.ll.Front(); e != nil {
-		st := e.Value.(*store)
+		st := e.Value.(*store[K, V])
 		delete(sf.data, st.key)
 		sf.ll.Remove(e)
 		return st.key, st.value, true
 	}
-	return nil, nil, false
+	return k, v, false
 }
 
 // PollBack return the back element value and then remove from list.
-func (sf *LinkedMap) PollBack() (k, v interface{}, exist bool) {
+func (sf *LinkedMapOf[K, V]) PollBack() (k K, v V, exist bool) {
 	if e := sf.ll.Back(); e != nil {
-		st := e.Value.(*store)
+		st := e.Value.(*store[K, V])
 		delete(sf.data, st.key)
 		sf.ll.Remove(e)
 		return st.key, st.value, true
 	}
-	return nil, nil, false
+	return k, v, false
 }
 
 // Remove removes the mapping for a key from this map if it is present.
 // It returns the value to which this map previously associated the key, and true,
-// or nil and false if the map contained no mapping for the key.
-func (sf *LinkedMap) Remove(k interface{}) (interface{}, bool) {
+// or the zero value and false if the map contained no mapping for the key.
+func (sf *LinkedMapOf[K, V]) Remove(k K) (val V, exist bool) {
 	if oldElement, ok := sf.data[k]; ok {
-		retVal := oldElement.Value.(*store).value
+		retVal := oldElement.Value.(*store[K, V]).value
 		delete(sf.data, k)
 		sf.ll.Remove(oldElement)
 		return retVal, true
 	}
-	return nil, false
+	return val, false
 }
 
 // Contains returns true if this map contains a mapping for the specified key.
-func (sf *LinkedMap) Contains(k interface{}) bool {
+func (sf *LinkedMapOf[K, V]) Contains(k K) bool {
 	_, ok := sf.data[k]
 	return ok
 }
 
 // ContainsValue returns true if this map maps one or more keys to the specified value.
-func (sf *LinkedMap) ContainsValue(v interface{}) bool {
+func (sf *LinkedMapOf[K, V]) ContainsValue(v V) bool {
 	for e := sf.ll.Front(); e != nil; e = e.Next() {
-		if sf.compare(e.Value.(*store).value, v) {
+		if sf.compare(e.Value.(*store[K, V]).value, v) {
 			return true
 		}
 	}
 	return false
 }
 
-// Get returns the value to which the specified key is mapped, or nil if this map contains no mapping for the key.
-func (sf *LinkedMap) Get(k interface{}, defaultValue ...interface{}) interface{} {
+// Get returns the value to which the specified key is mapped,
+// or the default value (the zero value if not given) if this map contains no mapping for the key.
+// The accessed item is moved to the back of the list.
+func (sf *LinkedMapOf[K, V]) Get(k K, defaultValue ...V) (val V) {
 	if old, ok := sf.data[k]; ok {
 		sf.ll.MoveToBack(old)
-		return old.Value.(*store).value
+		return old.Value.(*store[K, V]).value
 	}
 	if len(defaultValue) > 0 {
 		return defaultValue[0]
 	}
-	return nil
+	return val
 }
 
 // Peek return the front element value .
-func (sf *LinkedMap) Peek() (k, v interface{}, exist bool) {
+func (sf *LinkedMapOf[K, V]) Peek() (k K, v V, exist bool) {
 	return sf.PeekFront()
 }
 
 // PeekFront return the front element value.
-func (sf *LinkedMap) PeekFront() (k, v interface{}, exist bool) {
+func (sf *LinkedMapOf[K, V]) PeekFront() (k K, v V, exist bool) {
 	if e := sf.ll.Front(); e != nil {
-		st := e.Value.(*store)
+		st := e.Value.(*store[K, V])
 		return st.key, st.value, true
 	}
-	return nil, nil, false
+	return k, v, false
 }
 
 // PeekBack return the back element value .
-func (sf *LinkedMap) PeekBack() (k, v interface{}, exist bool) {
+func (sf *LinkedMapOf[K, V]) PeekBack() (k K, v V, exist bool) {
 	if e := sf.ll.Back(); e != nil {
-		st := e.Value.(*store)
+		st := e.Value.(*store[K, V])
 		return st.key, st.value, true
 	}
-	return nil, nil, false
+	return k, v, false
 }
 
 // Iterator iterator the list.
-func (sf *LinkedMap) Iterator(cb func(k, v interface{}) bool) {
+func (sf *LinkedMapOf[K, V]) Iterator(cb func(k K, v V) bool) {
 	for e := sf.ll.Front(); e != nil; e = e.Next() {
-		st := e.Value.(*store)
+		st := e.Value.(*store[K, V])
 		if cb == nil || !cb(st.key, st.value) {
 			return
 		}
@@ -235,18 +259,46 @@ func (sf *LinkedMap) Iterator(cb func(k, v interface{}) bool) {
 }
 
 // ReverseIterator reverse iterator the list.
-func (sf *LinkedMap) ReverseIterator(cb func(k, v interface{}) bool) {
+func (sf *LinkedMapOf[K, V]) ReverseIterator(cb func(k K, v V) bool) {
 	for e := sf.ll.Back(); e != nil; e = e.Prev() {
-		st := e.Value.(*store)
+		st := e.Value.(*store[K, V])
 		if cb == nil || !cb(st.key, st.value) {
 			return
 		}
 	}
 }
 
-func (sf *LinkedMap) compare(v1, v2 interface{}) bool {
+// All returns an iterator over the key-value pairs in this map in proper sequence.
+func (sf *LinkedMapOf[K, V]) All() iter.Seq2[K, V] { return sf.Iterator }
+
+// Backward returns an iterator over the key-value pairs in this map in reverse sequence.
+func (sf *LinkedMapOf[K, V]) Backward() iter.Seq2[K, V] { return sf.ReverseIterator }
+
+// Keys returns an iterator over the keys in this map in proper sequence.
+func (sf *LinkedMapOf[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for e := sf.ll.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.(*store[K, V]).key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in this map in proper sequence.
+func (sf *LinkedMapOf[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for e := sf.ll.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.(*store[K, V]).value) {
+				return
+			}
+		}
+	}
+}
+
+func (sf *LinkedMapOf[K, V]) compare(v1, v2 V) bool {
 	if sf.cmp != nil {
-		return sf.cmp.Compare(v1, v2) == 0
+		return sf.cmp(v1, v2) == 0
 	}
-	return v1 == v2
+	return any(v1) == any(v2)
 }
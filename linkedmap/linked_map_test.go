@@ -15,10 +15,14 @@
 package linkedmap
 
 import (
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
 )
 
 func TestLinkedMapLen(t *testing.T) {
@@ -209,3 +213,58 @@ func (s *student) Compare(v1, v2 interface{}) int {
 	}
 	return 0
 }
+
+func TestLinkedMapOf(t *testing.T) {
+	var lm container.LinkedMapOf[string, int] = NewOf[string, int](WithCap(2))
+	assert.Zero(t, lm.Push("alice", 21))
+	assert.Zero(t, lm.Push("john", 42))
+	assert.Equal(t, 21, lm.Push("alice", 22))
+	assert.Zero(t, lm.Push("roy", 28)) // remove john
+
+	assert.False(t, lm.Contains("john"))
+	assert.True(t, lm.ContainsValue(22))
+	assert.Equal(t, 28, lm.Get("roy"))
+	assert.Equal(t, 0, lm.Get("john"))
+	assert.Equal(t, -1, lm.Get("john", -1))
+
+	k, v, ok := lm.PeekFront()
+	assert.True(t, ok)
+	assert.Equal(t, "alice", k)
+	assert.Equal(t, 22, v)
+
+	v, ok = lm.Remove("alice")
+	assert.True(t, ok)
+	assert.Equal(t, 22, v)
+	_, ok = lm.Remove("alice")
+	assert.False(t, ok)
+
+	k, v, ok = lm.PollBack()
+	assert.True(t, ok)
+	assert.Equal(t, "roy", k)
+	assert.Equal(t, 28, v)
+
+	k, v, ok = lm.Poll()
+	assert.False(t, ok)
+	assert.Zero(t, k)
+	assert.Zero(t, v)
+}
+
+func TestLinkedMapAll(t *testing.T) {
+	m := NewOf[string, int]()
+	m.Push("a", 1)
+	m.Push("b", 2)
+	m.Push("c", 3)
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, maps.Collect(m.All()))
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(m.Keys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(m.Values()))
+
+	var keys []string
+	for k, v := range m.Backward() {
+		if v < 2 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"c", "b"}, keys)
+}
@@ -0,0 +1,74 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+	"github.com/thinkgos/container/arraylist"
+	"github.com/thinkgos/container/containertest"
+	"github.com/thinkgos/container/linkedlist"
+)
+
+// TestListEqual checks the lists of the different implementations with the same elements are equal,
+// the rest of container.List is verified by containertest in every implementation.
+func TestListEqual(t *testing.T) {
+	lists := []container.ExtendedListOf[int]{arraylist.NewOf[int](), linkedlist.NewOf[int]()}
+	for _, l := range lists {
+		require.NoError(t, l.AddAll(0, 70, 80, 90))
+	}
+	for _, l := range lists {
+		for _, other := range lists {
+			assert.True(t, l.Equal(other))
+		}
+	}
+}
+
+func TestListBulkWithComparator(t *testing.T) {
+	byLen := func(s1, s2 string) int { return len(s1) - len(s2) }
+	for _, l := range []container.ExtendedListOf[string]{
+		arraylist.NewOf[string](arraylist.WithCompareFunc(byLen)),
+		linkedlist.NewOf[string](linkedlist.WithCompareFunc(byLen)),
+	} {
+		require.NoError(t, l.AddAll(0, "a", "bb", "cc", "ddd"))
+		assert.Equal(t, 1, l.IndexOf("xx"))
+		assert.Equal(t, 2, l.LastIndexOf("xx"))
+		assert.Equal(t, 2, l.RetainAll("x", "yyy"))
+		assert.Equal(t, []string{"a", "ddd"}, l.Values())
+
+		c := l.Clone()
+		c.PushBack("e")
+		assert.Equal(t, 2, c.LastIndexOf("z"), "the comparator is kept")
+
+		other := arraylist.NewOf[string]()
+		other.PushBack("z")
+		other.PushBack("zzz")
+		assert.True(t, l.Equal(other))
+	}
+}
+
+// basicList hides the methods of container.ExtendedList, so it is only a container.List.
+type basicList struct {
+	container.ListOf[int]
+}
+
+// TestListBasic checks containertest verifies a container.List which isn't a container.ExtendedList.
+func TestListBasic(t *testing.T) {
+	containertest.TestList(t, func() container.ListOf[int] { return basicList{arraylist.NewOf[int]()} })
+}
@@ -0,0 +1,203 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testBackends = []struct {
+	name string
+	opts []Option
+}{
+	{"binary", []Option{WithBackend(BinaryHeap)}},
+	{"3-ary", []Option{WithBackend(DAryHeap), WithArity(3)}},
+	{"4-ary", []Option{WithBackend(DAryHeap)}},
+	{"pairing", []Option{WithBackend(PairingHeap)}},
+	{"fibonacci", []Option{WithBackend(FibonacciHeap)}},
+}
+
+func TestBackend(t *testing.T) {
+	for _, b := range testBackends {
+		t.Run(b.name, func(t *testing.T) {
+			pqTestPQSortImpl(t, New(b.opts...), []interface{}{15, 19, 12, 8, 13}, []interface{}{8, 12, 13, 15, 19})
+			pqTestPQSortImpl(t, New(append(b.opts, WithMaxHeap(true))...),
+				[]interface{}{15, 19, 12, 8, 13}, []interface{}{19, 15, 13, 12, 8})
+			pqTestPQDeleteImpl(t, New(append(b.opts, WithComparator(&myInt{}))...),
+				[]interface{}{15, 19, 12, 8, 13}, []interface{}{19, 13, 12, 8}, 15)
+
+			q := NewOf[int](b.opts...)
+			assert.Zero(t, q.Peek())
+			assert.Zero(t, q.Poll())
+			for _, v := range []int{5, 1, 4, 2, 3} {
+				q.Add(v)
+			}
+			assert.True(t, q.Contains(4))
+			assert.False(t, q.Contains(6))
+			assert.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(q.All()))
+			assert.Equal(t, []int{5, 4, 3, 2, 1}, slices.Collect(q.Backward()))
+			assert.Equal(t, 5, q.Len())
+			q.Clear()
+			assert.True(t, q.IsEmpty())
+		})
+	}
+}
+
+func TestBackendRandom(t *testing.T) {
+	for _, b := range testBackends {
+		t.Run(b.name, func(t *testing.T) {
+			q := NewOf[int](b.opts...)
+			var want []int
+			for i := 0; i < 5000; i++ {
+				switch v := rand.Intn(1000); rand.Intn(4) {
+				case 0, 1:
+					q.Add(v)
+					want = append(want, v)
+				case 2:
+					if len(want) > 0 {
+						sort.Ints(want)
+						require.Equal(t, want[0], q.Poll())
+						want = want[1:]
+					}
+				case 3:
+					if idx := slices.Index(want, v); idx >= 0 {
+						require.True(t, q.Contains(v))
+						q.Remove(v)
+						want = slices.Delete(want, idx, idx+1)
+					} else {
+						require.False(t, q.Contains(v))
+					}
+				}
+				require.Equal(t, len(want), q.Len())
+			}
+			sort.Ints(want)
+			assert.Equal(t, want, q.Sorted())
+			for _, v := range want {
+				require.Equal(t, v, q.Poll())
+			}
+			assert.True(t, q.IsEmpty())
+		})
+	}
+}
+
+func TestBackendStable(t *testing.T) {
+	type job struct {
+		name     string
+		priority int
+	}
+	jobs := []job{{"a", 2}, {"b", 1}, {"c", 2}, {"d", 1}, {"e", 2}, {"f", 1}, {"g", 3}, {"h", 2}}
+	for _, b := range testBackends {
+		t.Run(b.name, func(t *testing.T) {
+			q := NewOf[job](append(b.opts, WithStable(true),
+				WithCompareFunc(func(j1, j2 job) int { return j1.priority - j2.priority }))...)
+			for _, j := range jobs {
+				q.Add(j)
+			}
+			q.Remove(job{"", 3})
+			names := ""
+			for !q.IsEmpty() {
+				names += q.Poll().name
+			}
+			assert.Equal(t, "bdfaceh", names)
+		})
+	}
+}
+
+func TestBackendMerge(t *testing.T) {
+	for _, b1 := range testBackends {
+		for _, b2 := range testBackends {
+			t.Run(b1.name+"+"+b2.name, func(t *testing.T) {
+				q1 := NewOf[int](b1.opts...)
+				q2 := NewOf[int](b2.opts...)
+				for i := 0; i < 100; i++ {
+					if i%3 == 0 {
+						q1.Add(i)
+					} else {
+						q2.Add(i)
+					}
+				}
+				q1.Merge(q2)
+				q1.Merge(q1)
+				q1.Merge(nil)
+				assert.True(t, q2.IsEmpty())
+				assert.Equal(t, 100, q1.Len())
+				for i := 0; i < 100; i++ {
+					require.Equal(t, i, q1.Poll())
+				}
+
+				// merge into a bounded queue keeps the greatest
+				q1 = NewOf[int](append(b1.opts, WithCapacity(3))...)
+				q1.Add(1)
+				q2 = NewOf[int](b2.opts...)
+				for _, v := range []int{5, 2, 7, 4} {
+					q2.Add(v)
+				}
+				q1.Merge(q2)
+				assert.Equal(t, []int{4, 5, 7}, q1.Sorted())
+			})
+		}
+	}
+}
+
+func BenchmarkBackendAdd(b *testing.B) {
+	for _, bk := range testBackends {
+		b.Run(bk.name, func(b *testing.B) {
+			q := NewOf[int](bk.opts...)
+			for i := 0; i < b.N; i++ {
+				q.Add(rand.Int())
+			}
+		})
+	}
+}
+
+func BenchmarkBackendAddPoll(b *testing.B) {
+	for _, size := range []int{1000, 100000} {
+		for _, bk := range testBackends {
+			b.Run(fmt.Sprintf("%s/%d", bk.name, size), func(b *testing.B) {
+				q := NewOf[int](bk.opts...)
+				for i := 0; i < size; i++ {
+					q.Add(rand.Int())
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					q.Add(rand.Int())
+					q.Poll()
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkBackendMerge(b *testing.B) {
+	for _, bk := range testBackends {
+		b.Run(bk.name, func(b *testing.B) {
+			q := NewOf[int](bk.opts...)
+			for i := 0; i < b.N; i++ {
+				other := NewOf[int](bk.opts...)
+				for j := 0; j < 100; j++ {
+					other.Add(j)
+				}
+				q.Merge(other)
+			}
+		})
+	}
+}
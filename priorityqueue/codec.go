@@ -0,0 +1,68 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+import (
+	"github.com/thinkgos/container"
+)
+
+// MarshalJSON implement json.Marshaler, the elements are encoded as a JSON array in priority order,
+// so the FIFO order among equal priorities is kept by WithStable after decoding.
+// The elements of interface type are encoded as container.JSONValue.
+func (sf *QueueOf[T]) MarshalJSON() ([]byte, error) {
+	return container.MarshalJSONValues(sf.Sorted())
+}
+
+// UnmarshalJSON implement json.Unmarshaler, it replaces the elements of this priority queue,
+// the comparator and the heap order are kept, the heap is re-established by them.
+func (sf *QueueOf[T]) UnmarshalJSON(data []byte) error {
+	items, err := container.UnmarshalJSONValues[T](data)
+	if err != nil {
+		return err
+	}
+	sf.reset(items)
+	return nil
+}
+
+// MarshalBinary implement encoding.BinaryMarshaler, the elements are encoded by gob in priority order,
+// so the FIFO order among equal priorities is kept by WithStable after decoding.
+func (sf *QueueOf[T]) MarshalBinary() ([]byte, error) { return container.GobEncode(sf.Sorted()) }
+
+// UnmarshalBinary implement encoding.BinaryUnmarshaler, it replaces the elements of this priority queue,
+// the comparator and the heap order are kept, the heap is re-established by them.
+func (sf *QueueOf[T]) UnmarshalBinary(data []byte) error {
+	var items []T
+	if err := container.GobDecode(data, &items); err != nil {
+		return err
+	}
+	sf.reset(items)
+	return nil
+}
+
+// GobEncode implement gob.GobEncoder.
+func (sf *QueueOf[T]) GobEncode() ([]byte, error) { return sf.MarshalBinary() }
+
+// GobDecode implement gob.GobDecoder.
+func (sf *QueueOf[T]) GobDecode(data []byte) error { return sf.UnmarshalBinary(data) }
+
+// reset replaces the heap contents with items, it initializes the queue if it is the zero value.
+// In stable mode the sequence numbers follow the order of items, which is the priority order when decoding.
+func (sf *QueueOf[T]) reset(items []T) {
+	if sf.ctn == nil {
+		sf.ctn = &heapData[T]{order: newOrder[T](nil, false, false), arity: 2}
+	}
+	sf.ctn.reset(items)
+	sf.trim()
+}
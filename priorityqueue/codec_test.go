@@ -0,0 +1,91 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPQCodec(t *testing.T) {
+	q := New()
+	for _, v := range []interface{}{5, 1, 4, 2, 3} {
+		q.Add(v)
+	}
+
+	data, err := json.Marshal(q)
+	require.NoError(t, err)
+	got := New()
+	got.Add(100)
+	require.NoError(t, json.Unmarshal(data, got))
+	assert.Equal(t, slices.Collect(q.All()), slices.Collect(got.All()))
+
+	data, err = q.MarshalBinary()
+	require.NoError(t, err)
+	got = New()
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, slices.Collect(q.All()), slices.Collect(got.All()))
+
+	// the heap is re-established by the receiver's order.
+	got = New(WithMaxHeap(true))
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, []interface{}{5, 4, 3, 2, 1}, slices.Collect(got.All()))
+
+	// gob with the zero value
+	qi := NewOf[int]()
+	qi.Add(2)
+	qi.Add(1)
+	qi.Add(3)
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(qi))
+	var gotInt QueueOf[int]
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&gotInt))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(gotInt.All()))
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), got))
+	assert.Error(t, got.UnmarshalBinary([]byte("bad")))
+}
+
+func TestPQCodecStable(t *testing.T) {
+	type task struct {
+		Priority int
+		ID       int
+	}
+	byPriority := WithCompareFunc(func(t1, t2 task) int { return t1.Priority - t2.Priority })
+	q := NewOf[task](byPriority, WithStable(true))
+	for i := 0; i < 8; i++ {
+		q.Add(task{i % 2, i})
+	}
+	q.Add(task{-1, 99})
+	want := q.Sorted()
+
+	data, err := json.Marshal(q)
+	require.NoError(t, err)
+	got := NewOf[task](byPriority, WithStable(true))
+	require.NoError(t, json.Unmarshal(data, got))
+	assert.Equal(t, want, got.PollN(got.Len()), "json round-trip should keep the FIFO order among equal priorities")
+
+	data, err = q.MarshalBinary()
+	require.NoError(t, err)
+	got = NewOf[task](byPriority, WithStable(true))
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, want, got.PollN(got.Len()), "binary round-trip should keep the FIFO order among equal priorities")
+}
@@ -0,0 +1,280 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+type fibNode[T any] struct {
+	val    T
+	seq    uint64
+	parent *fibNode[T]
+	child  *fibNode[T]
+	// left and right link the node in a circular doubly linked list of its siblings.
+	left, right *fibNode[T]
+	degree      int
+	mark        bool
+}
+
+// fibHeap is the Fibonacci heap of T elements, push and merge are O(1),
+// pop and remove are O(log n) amortized.
+type fibHeap[T any] struct {
+	order[T]
+	min  *fibNode[T]
+	size int
+}
+
+func (h *fibHeap[T]) less(a, b *fibNode[T]) bool {
+	return h.order.less(a.val, a.seq, b.val, b.seq)
+}
+
+// splice joins the circular lists of a and b.
+func splice[T any](a, b *fibNode[T]) {
+	ar, bl := a.right, b.left
+	a.right, b.left = b, a
+	ar.left, bl.right = bl, ar
+}
+
+// unlink removes n from its circular list, n becomes a single node list.
+func unlink[T any](n *fibNode[T]) {
+	n.left.right, n.right.left = n.right, n.left
+	n.left, n.right = n, n
+}
+
+// addRoot adds the single node list n to the root list.
+func (h *fibHeap[T]) addRoot(n *fibNode[T]) {
+	n.parent, n.mark = nil, false
+	if h.min == nil {
+		h.min = n
+		return
+	}
+	splice(h.min, n)
+	if h.less(n, h.min) {
+		h.min = n
+	}
+}
+
+func (h *fibHeap[T]) Len() int { return h.size }
+
+func (h *fibHeap[T]) push(v T) {
+	h.pushSeq(v, h.seq())
+}
+
+func (h *fibHeap[T]) pushSeq(v T, seq uint64) {
+	n := &fibNode[T]{val: v, seq: seq}
+	n.left, n.right = n, n
+	h.addRoot(n)
+	h.size++
+}
+
+func (h *fibHeap[T]) peek() T { return h.min.val }
+
+func (h *fibHeap[T]) pop() T {
+	z := h.min
+	// move the children to the root list
+	for c := z.child; c != nil; c = z.child {
+		if c.right == c {
+			z.child = nil
+		} else {
+			z.child = c.right
+		}
+		unlink(c)
+		c.parent, c.mark = nil, false
+		splice(z, c)
+	}
+	z.degree = 0
+	if z.right == z {
+		h.min = nil
+	} else {
+		h.min = z.right
+		unlink(z)
+		h.consolidate()
+	}
+	h.size--
+	return z.val
+}
+
+// consolidate links the roots of the same degree until every root has a distinct degree.
+func (h *fibHeap[T]) consolidate() {
+	var roots []*fibNode[T]
+	for n := h.min; ; {
+		roots = append(roots, n)
+		if n = n.right; n == h.min {
+			break
+		}
+	}
+	var degrees []*fibNode[T]
+	for _, x := range roots {
+		unlink(x)
+		for {
+			for x.degree >= len(degrees) {
+				degrees = append(degrees, nil)
+			}
+			y := degrees[x.degree]
+			if y == nil {
+				break
+			}
+			degrees[x.degree] = nil
+			if h.less(y, x) {
+				x, y = y, x
+			}
+			// y becomes a child of x
+			y.parent, y.mark = x, false
+			if x.child == nil {
+				x.child = y
+			} else {
+				splice(x.child, y)
+			}
+			x.degree++
+		}
+		degrees[x.degree] = x
+	}
+	h.min = nil
+	for _, n := range degrees {
+		if n != nil {
+			h.addRoot(n)
+		}
+	}
+}
+
+// cut moves n from the children of its parent to the root list.
+func (h *fibHeap[T]) cut(n *fibNode[T]) {
+	p := n.parent
+	if p.child == n {
+		if n.right == n {
+			p.child = nil
+		} else {
+			p.child = n.right
+		}
+	}
+	unlink(n)
+	p.degree--
+	h.addRoot(n)
+}
+
+// cascadingCut cuts the marked ancestors of n.
+func (h *fibHeap[T]) cascadingCut(n *fibNode[T]) {
+	for p := n.parent; p != nil; n, p = p, p.parent {
+		if !n.mark {
+			n.mark = true
+			return
+		}
+		h.cut(n)
+	}
+}
+
+func (h *fibHeap[T]) removeFunc(f func(T) bool) bool {
+	var found *fibNode[T]
+	h.walkNode(func(n *fibNode[T]) bool {
+		if f(n.val) {
+			found = n
+			return false
+		}
+		return true
+	})
+	if found == nil {
+		return false
+	}
+	// decrease the key to the minimum, then pop it.
+	if p := found.parent; p != nil {
+		h.cut(found)
+		h.cascadingCut(p)
+	}
+	h.min = found
+	h.pop()
+	return true
+}
+
+// walkNode calls f for every node in depth-first order, until f returns false.
+func (h *fibHeap[T]) walkNode(f func(n *fibNode[T]) bool) {
+	if h.min == nil {
+		return
+	}
+	stack := []*fibNode[T]{h.min}
+	for len(stack) > 0 {
+		first := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for n := first; ; {
+			if !f(n) {
+				return
+			}
+			if n.child != nil {
+				stack = append(stack, n.child)
+			}
+			if n = n.right; n == first {
+				break
+			}
+		}
+	}
+}
+
+func (h *fibHeap[T]) pushAll(vals []T) {
+	for _, v := range vals {
+		h.push(v)
+	}
+}
+
+// removeIf rebuilds the heap with the remaining nodes in O(n).
+func (h *fibHeap[T]) removeIf(f func(T) bool) int {
+	var remains []*fibNode[T]
+	h.walkNode(func(n *fibNode[T]) bool {
+		if !f(n.val) {
+			remains = append(remains, n)
+		}
+		return true
+	})
+	removed := h.size - len(remains)
+	if removed > 0 {
+		h.min, h.size = nil, 0
+		for _, n := range remains {
+			h.pushSeq(n.val, n.seq)
+		}
+	}
+	return removed
+}
+
+func (h *fibHeap[T]) walk(f func(T) bool) {
+	h.walkNode(func(n *fibNode[T]) bool { return f(n.val) })
+}
+
+func (h *fibHeap[T]) reset(items []T) {
+	h.min, h.size, h.next = nil, 0, 0
+	for _, v := range items {
+		h.push(v)
+	}
+}
+
+func (h *fibHeap[T]) clone() backend[T] {
+	c := &fibHeap[T]{order: h.order}
+	h.walkNode(func(n *fibNode[T]) bool {
+		c.pushSeq(n.val, n.seq)
+		return true
+	})
+	return c
+}
+
+// merge moves all the elements of other into this heap in O(1), other becomes empty.
+func (h *fibHeap[T]) merge(other *fibHeap[T]) {
+	if other.min != nil {
+		if h.min == nil {
+			h.min = other.min
+		} else {
+			splice(h.min, other.min)
+			if h.less(other.min, h.min) {
+				h.min = other.min
+			}
+		}
+	}
+	h.size += other.size
+	h.next = max(h.next, other.next)
+	other.min, other.size = nil, 0
+}
@@ -0,0 +1,287 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+import (
+	"github.com/thinkgos/container/comparator"
+)
+
+// backend is the heap which stores the elements of QueueOf.
+type backend[T any] interface {
+	// Len returns the number of elements.
+	Len() int
+	// push inserts v.
+	push(v T)
+	// peek returns the head, the heap must not be empty.
+	peek() T
+	// pop removes and returns the head, the heap must not be empty.
+	pop() T
+	// removeFunc removes the first element which f returns true, it returns false if none is found.
+	removeFunc(f func(T) bool) bool
+	// pushAll inserts all the vals.
+	pushAll(vals []T)
+	// removeIf removes all the elements which f returns true and re-establishes the heap once,
+	// it returns the number of the removed elements.
+	removeIf(f func(T) bool) int
+	// walk calls f for every element in storage order, until f returns false.
+	walk(f func(T) bool)
+	// reset replaces the elements with items and re-establishes the heap,
+	// in stable mode the sequence numbers follow the order of items.
+	reset(items []T)
+	// clone returns a copy of the heap.
+	clone() backend[T]
+	// ordering returns the ordering of the heap.
+	ordering() *order[T]
+}
+
+// order is the ordering of the elements shared by the backends.
+type order[T any] struct {
+	// cmp is the comparator of the options, nil means the elements are equal by ==.
+	cmp comparator.CompareFunc[T]
+	// by is cmp, or the natural ordering of T if cmp is nil.
+	by      comparator.CompareFunc[T]
+	reverse bool
+	// stable is set when the insertion order breaks the ties of equal elements,
+	// every element holds a sequence number, next is the next one.
+	stable bool
+	next   uint64
+}
+
+// newOrder returns the ordering by cmp, it panics if cmp is nil and T has no natural ordering.
+func newOrder[T any](cmp comparator.CompareFunc[T], reverse, stable bool) order[T] {
+	return order[T]{cmp: cmp, by: comparator.OrderOf(cmp), reverse: reverse, stable: stable}
+}
+
+func (o *order[T]) ordering() *order[T] { return o }
+
+// compare compares v1 and v2 in the heap order, the head of the heap is the least.
+func (o *order[T]) compare(v1, v2 T) int {
+	if o.reverse {
+		v1, v2 = v2, v1
+	}
+	return o.by(v1, v2)
+}
+
+// less reports whether v1 with sequence number s1 goes before v2 with sequence number s2.
+func (o *order[T]) less(v1 T, s1 uint64, v2 T, s2 uint64) bool {
+	r := o.compare(v1, v2)
+	if r == 0 && o.stable {
+		return s1 < s2
+	}
+	return r < 0
+}
+
+// equal reports whether v1 and v2 are the same element for Contains and Remove.
+func (o *order[T]) equal(v1, v2 T) bool {
+	if o.cmp != nil {
+		return o.cmp(v1, v2) == 0
+	}
+	return any(v1) == any(v2)
+}
+
+// seq returns the next sequence number.
+func (o *order[T]) seq() uint64 {
+	s := o.next
+	o.next++
+	return s
+}
+
+// heapData is the d-ary heap of T elements on a slice, the binary heap is the 2-ary one.
+type heapData[T any] struct {
+	order[T]
+	arity int
+	items []T
+	// seqs holds the sequence number of every element in items in stable mode.
+	seqs []uint64
+}
+
+// Len returns the number of elements.
+func (h *heapData[T]) Len() int { return len(h.items) }
+
+// Swap swaps the elements with indexes i and j.
+func (h *heapData[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	if h.stable {
+		h.seqs[i], h.seqs[j] = h.seqs[j], h.seqs[i]
+	}
+}
+
+// Less reports whether the element with index i goes before the element with index j.
+func (h *heapData[T]) Less(i, j int) bool {
+	r := h.compare(h.items[i], h.items[j])
+	if r == 0 && h.stable {
+		return h.seqs[i] < h.seqs[j]
+	}
+	return r < 0
+}
+
+func (h *heapData[T]) d() int { return max(h.arity, 2) }
+
+func (h *heapData[T]) up(j int) {
+	d := h.d()
+	for j > 0 {
+		i := (j - 1) / d // parent
+		if !h.Less(j, i) {
+			break
+		}
+		h.Swap(i, j)
+		j = i
+	}
+}
+
+func (h *heapData[T]) down(i0, n int) bool {
+	d := h.d()
+	i := i0
+	for {
+		first := d*i + 1
+		if first >= n || first < 0 { // first < 0 after int overflow
+			break
+		}
+		j := first // the least child
+		for c := first + 1; c < first+d && c < n; c++ {
+			if h.Less(c, j) {
+				j = c
+			}
+		}
+		if !h.Less(j, i) {
+			break
+		}
+		h.Swap(i, j)
+		i = j
+	}
+	return i > i0
+}
+
+func (h *heapData[T]) init() {
+	n := h.Len()
+	for i := (n - 2) / h.d(); i >= 0; i-- {
+		h.down(i, n)
+	}
+}
+
+// removeAt removes and returns the element with index i.
+func (h *heapData[T]) removeAt(i int) T {
+	n := h.Len() - 1
+	if n != i {
+		h.Swap(i, n)
+		if !h.down(i, n) {
+			h.up(i)
+		}
+	}
+
+	var zero T
+
+	x := h.items[n]
+	h.items[n] = zero // should set zero for gc
+	h.items = h.items[:n]
+	if h.stable {
+		h.seqs = h.seqs[:n]
+	}
+	return x
+}
+
+func (h *heapData[T]) push(v T) {
+	h.items = append(h.items, v)
+	if h.stable {
+		h.seqs = append(h.seqs, h.seq())
+	}
+	h.up(h.Len() - 1)
+}
+
+// pushAll appends vals and re-establishes the heap in O(n) if there are many of them,
+// otherwise they are sifted up one by one.
+func (h *heapData[T]) pushAll(vals []T) {
+	n := h.Len()
+	h.items = append(h.items, vals...)
+	if h.stable {
+		for range vals {
+			h.seqs = append(h.seqs, h.seq())
+		}
+	}
+	if len(vals) > n/2 {
+		h.init()
+		return
+	}
+	for i := n; i < h.Len(); i++ {
+		h.up(i)
+	}
+}
+
+func (h *heapData[T]) peek() T { return h.items[0] }
+
+func (h *heapData[T]) pop() T { return h.removeAt(0) }
+
+func (h *heapData[T]) removeFunc(f func(T) bool) bool {
+	for i, v := range h.items {
+		if f(v) {
+			h.removeAt(i)
+			return true
+		}
+	}
+	return false
+}
+
+func (h *heapData[T]) removeIf(f func(T) bool) int {
+	j := 0
+	for i, v := range h.items {
+		if f(v) {
+			continue
+		}
+		h.items[j] = v
+		if h.stable {
+			h.seqs[j] = h.seqs[i]
+		}
+		j++
+	}
+	removed := h.Len() - j
+	clear(h.items[j:]) // should set zero for gc
+	h.items = h.items[:j]
+	if h.stable {
+		h.seqs = h.seqs[:j]
+	}
+	if removed > 0 {
+		h.init()
+	}
+	return removed
+}
+
+func (h *heapData[T]) walk(f func(T) bool) {
+	for _, v := range h.items {
+		if !f(v) {
+			return
+		}
+	}
+}
+
+func (h *heapData[T]) reset(items []T) {
+	h.items = items
+	h.seqs, h.next = nil, 0
+	if h.stable {
+		h.seqs = make([]uint64, len(items))
+		for i := range h.seqs {
+			h.seqs[i] = h.seq()
+		}
+	}
+	h.init()
+}
+
+func (h *heapData[T]) clone() backend[T] {
+	return &heapData[T]{
+		order: h.order,
+		arity: h.arity,
+		items: append([]T(nil), h.items...),
+		seqs:  append([]uint64(nil), h.seqs...),
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+import (
+	"container/heap"
+	"iter"
+	"maps"
+
+	"github.com/thinkgos/container/comparator"
+)
+
+var _ heap.Interface = (*indexedHeap[int, interface{}])(nil)
+
+type indexedEntry[K comparable, V any] struct {
+	key K
+	val V
+}
+
+// indexedHeap is the binary heap of keyed entries, it keeps the position of every key.
+type indexedHeap[K comparable, V any] struct {
+	entries []indexedEntry[K, V]
+	index   map[K]int
+	cmp     comparator.CompareFunc[V]
+	reverse bool
+}
+
+// Len implement heap.Interface.
+func (h *indexedHeap[K, V]) Len() int { return len(h.entries) }
+
+// Swap implement heap.Interface.
+func (h *indexedHeap[K, V]) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+	h.index[h.entries[i].key] = i
+	h.index[h.entries[j].key] = j
+}
+
+// Less implement heap.Interface.
+func (h *indexedHeap[K, V]) Less(i, j int) bool {
+	if h.reverse {
+		i, j = j, i
+	}
+	return h.cmp(h.entries[i].val, h.entries[j].val) < 0
+}
+
+// Push implement heap.Interface.
+func (h *indexedHeap[K, V]) Push(x interface{}) {
+	e, _ := x.(indexedEntry[K, V])
+	h.index[e.key] = len(h.entries)
+	h.entries = append(h.entries, e)
+}
+
+// Pop implement heap.Interface.
+func (h *indexedHeap[K, V]) Pop() interface{} {
+	n := len(h.entries)
+	e := h.entries[n-1]
+	h.entries[n-1] = indexedEntry[K, V]{} // should set zero for gc
+	h.entries = h.entries[:n-1]
+	delete(h.index, e.key)
+	return e
+}
+
+// IndexedOf represents an indexed priority queue, every element is a value of V identified by a unique key of K.
+// The elements are ordered by their values, the key gives O(1) Contains and Get,
+// and O(log n) Update and Remove, which is what Dijkstra, A* or a scheduler bumping priorities needs.
+type IndexedOf[K comparable, V any] struct {
+	ctn *indexedHeap[K, V]
+}
+
+// NewIndexedOf initializes and returns an IndexedOf, default min heap.
+// Only the options WithComparator, WithCompareFunc and WithMaxHeap are used, the comparator compares the values of V.
+// The others are ignored, it is always an unbounded binary heap, and the order among equal values is unspecified.
+// It panics if there is no comparator and V has no natural ordering, see comparator.NaturalOf.
+func NewIndexedOf[K comparable, V any](opts ...Option) *IndexedOf[K, V] {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &IndexedOf[K, V]{
+		&indexedHeap[K, V]{
+			index:   make(map[K]int),
+			cmp:     comparator.OrderOf(comparator.FuncOf[V](o.cmp)),
+			reverse: o.maxHeap,
+		},
+	}
+}
+
+// Len returns the length of this priority queue.
+func (sf *IndexedOf[K, V]) Len() int { return sf.ctn.Len() }
+
+// IsEmpty returns true if this priority queue contains no elements.
+func (sf *IndexedOf[K, V]) IsEmpty() bool { return sf.Len() == 0 }
+
+// Clear removes all of the elements from this priority queue.
+func (sf *IndexedOf[K, V]) Clear() {
+	sf.ctn.entries = nil
+	sf.ctn.index = make(map[K]int)
+}
+
+// Add inserts the value with the key into this priority queue,
+// if the key is already present, its value is updated like Update.
+func (sf *IndexedOf[K, V]) Add(key K, val V) {
+	if i, ok := sf.ctn.index[key]; ok {
+		sf.ctn.entries[i].val = val
+		heap.Fix(sf.ctn, i)
+		return
+	}
+	heap.Push(sf.ctn, indexedEntry[K, V]{key, val})
+}
+
+// Update changes the value of the key and re-establishes its position, in O(log n).
+// It returns false if the key isn't present.
+func (sf *IndexedOf[K, V]) Update(key K, val V) bool {
+	i, ok := sf.ctn.index[key]
+	if ok {
+		sf.ctn.entries[i].val = val
+		heap.Fix(sf.ctn, i)
+	}
+	return ok
+}
+
+// Get returns the value of the key, and whether the key is present.
+func (sf *IndexedOf[K, V]) Get(key K) (val V, ok bool) {
+	if i, ok := sf.ctn.index[key]; ok {
+		return sf.ctn.entries[i].val, true
+	}
+	return val, false
+}
+
+// Contains returns true if this priority queue contains the key, in O(1).
+func (sf *IndexedOf[K, V]) Contains(key K) bool {
+	_, ok := sf.ctn.index[key]
+	return ok
+}
+
+// Remove removes the key from this priority queue, in O(log n).
+// It returns the removed value, and false if the key isn't present.
+func (sf *IndexedOf[K, V]) Remove(key K) (val V, ok bool) {
+	i, ok := sf.ctn.index[key]
+	if !ok {
+		return val, false
+	}
+	e, _ := heap.Remove(sf.ctn, i).(indexedEntry[K, V])
+	return e.val, true
+}
+
+// Peek retrieves, but does not remove, the head of this priority queue,
+// it returns false if this priority queue is empty.
+func (sf *IndexedOf[K, V]) Peek() (key K, val V, ok bool) {
+	if sf.Len() > 0 {
+		e := sf.ctn.entries[0]
+		return e.key, e.val, true
+	}
+	return key, val, false
+}
+
+// Poll retrieves and removes the head of this priority queue,
+// it returns false if this priority queue is empty.
+func (sf *IndexedOf[K, V]) Poll() (key K, val V, ok bool) {
+	if sf.Len() > 0 {
+		e, _ := heap.Pop(sf.ctn).(indexedEntry[K, V])
+		return e.key, e.val, true
+	}
+	return key, val, false
+}
+
+// All returns an iterator over the key-value pairs in this priority queue in sorted order,
+// it does not consume the queue, the elements are popped lazily from a copy of the heap.
+func (sf *IndexedOf[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		h := &indexedHeap[K, V]{
+			entries: append([]indexedEntry[K, V](nil), sf.ctn.entries...),
+			index:   maps.Clone(sf.ctn.index),
+			cmp:     sf.ctn.cmp,
+			reverse: sf.ctn.reverse,
+		}
+		for h.Len() > 0 {
+			e, _ := heap.Pop(h).(indexedEntry[K, V])
+			if !yield(e.key, e.val) {
+				return
+			}
+		}
+	}
+}
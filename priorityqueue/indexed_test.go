@@ -0,0 +1,152 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+import (
+	"maps"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexed(t *testing.T) {
+	q := NewIndexedOf[string, int]()
+	assert.True(t, q.IsEmpty())
+	_, _, ok := q.Peek()
+	assert.False(t, ok)
+	_, _, ok = q.Poll()
+	assert.False(t, ok)
+
+	q.Add("a", 5)
+	q.Add("b", 3)
+	q.Add("c", 8)
+	q.Add("d", 1)
+	require.Equal(t, 4, q.Len())
+	assert.True(t, q.Contains("c"))
+	assert.False(t, q.Contains("z"))
+
+	k, v, ok := q.Peek()
+	require.True(t, ok)
+	assert.Equal(t, "d", k)
+	assert.Equal(t, 1, v)
+
+	// decrease key
+	assert.True(t, q.Update("c", 0))
+	assert.False(t, q.Update("z", 0))
+	k, _, _ = q.Peek()
+	assert.Equal(t, "c", k)
+
+	// increase key by Add with an existing key
+	q.Add("c", 10)
+	assert.Equal(t, 4, q.Len())
+	v, ok = q.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+	_, ok = q.Get("z")
+	assert.False(t, ok)
+
+	v, ok = q.Remove("b")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	_, ok = q.Remove("b")
+	assert.False(t, ok)
+	assert.False(t, q.Contains("b"))
+
+	var keys []string
+	for k := range q.All() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"d", "a", "c"}, keys)
+	assert.Equal(t, 3, q.Len())
+
+	for _, want := range []string{"d", "a", "c"} {
+		k, _, ok = q.Poll()
+		require.True(t, ok)
+		assert.Equal(t, want, k)
+		assert.False(t, q.Contains(k))
+	}
+	assert.True(t, q.IsEmpty())
+
+	q.Add("x", 1)
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+	assert.False(t, q.Contains("x"))
+}
+
+func TestIndexedMaxHeapWithComparator(t *testing.T) {
+	q := NewIndexedOf[int, *student](WithCompareFunc(func(s1, s2 *student) int { return s1.age - s2.age }), WithMaxHeap(true))
+	q.Add(1, &student{name: "benjamin", age: 34})
+	q.Add(2, &student{name: "alice", age: 21})
+	q.Add(3, &student{name: "john", age: 42})
+	q.Update(2, &student{name: "alice", age: 50})
+
+	_, v, _ := q.Poll()
+	assert.Equal(t, "alice", v.name)
+	_, v, _ = q.Poll()
+	assert.Equal(t, "john", v.name)
+	_, v, _ = q.Poll()
+	assert.Equal(t, "benjamin", v.name)
+}
+
+func TestIndexedIgnoredOptions(t *testing.T) {
+	q := NewIndexedOf[string, int](WithMaxHeap(true), WithCapacity(1), WithStable(true),
+		WithBackend(PairingHeap), WithArity(3))
+	q.Add("a", 1)
+	q.Add("b", 3)
+	q.Add("c", 2)
+	assert.Equal(t, 3, q.Len(), "WithCapacity is ignored")
+	for _, want := range []string{"b", "c", "a"} {
+		k, _, ok := q.Poll()
+		assert.True(t, ok)
+		assert.Equal(t, want, k)
+	}
+}
+
+func TestIndexedRandom(t *testing.T) {
+	q := NewIndexedOf[int, int]()
+	want := make(map[int]int)
+	for i := 0; i < 1000; i++ {
+		k := rand.Intn(200)
+		switch rand.Intn(3) {
+		case 0, 1:
+			v := rand.Intn(10000)
+			q.Add(k, v)
+			want[k] = v
+		case 2:
+			_, ok := q.Remove(k)
+			_, exist := want[k]
+			require.Equal(t, exist, ok)
+			delete(want, k)
+		}
+		require.Equal(t, len(want), q.Len())
+	}
+
+	values := make([]int, 0, len(want))
+	for _, v := range want {
+		values = append(values, v)
+	}
+	sort.Ints(values)
+	got := make([]int, 0, len(want))
+	for !q.IsEmpty() {
+		k, v, _ := q.Poll()
+		require.Equal(t, want[k], v)
+		got = append(got, v)
+	}
+	assert.Equal(t, values, got)
+	assert.Empty(t, maps.Collect(q.All()))
+}
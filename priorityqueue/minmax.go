@@ -0,0 +1,223 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+import (
+	"iter"
+	"math/bits"
+
+	"github.com/thinkgos/container/comparator"
+)
+
+// MinMax represents a double-ended priority queue of interface{} elements based on a min-max heap.
+type MinMax = MinMaxOf[interface{}]
+
+// MinMaxOf represents a double-ended priority queue of T elements based on a min-max heap.
+// Both the least and the greatest elements are retrieved in O(1) and removed in O(log n).
+// The nodes on the even levels are less than or equal to their descendants,
+// the nodes on the odd levels are greater than or equal to their descendants.
+type MinMaxOf[T any] struct {
+	items []T
+	cmp   comparator.CompareFunc[T]
+}
+
+// NewMinMax initializes and returns a MinMax.
+// Only the comparator options WithComparator and WithCompareFunc are used, the others are ignored.
+func NewMinMax(opts ...Option) *MinMax {
+	return NewMinMaxOf[interface{}](opts...)
+}
+
+// NewMinMaxOf initializes and returns a MinMaxOf of T elements.
+// It panics if there is no comparator and T has no natural ordering, see comparator.NaturalOf.
+// Only the comparator options WithComparator and WithCompareFunc are used, the others are ignored.
+func NewMinMaxOf[T any](opts ...Option) *MinMaxOf[T] {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &MinMaxOf[T]{cmp: comparator.OrderOf(comparator.FuncOf[T](o.cmp))}
+}
+
+// Len returns the length of this priority queue.
+func (sf *MinMaxOf[T]) Len() int { return len(sf.items) }
+
+// IsEmpty returns true if this priority queue contains no elements.
+func (sf *MinMaxOf[T]) IsEmpty() bool { return sf.Len() == 0 }
+
+// Clear removes all of the elements from this priority queue.
+func (sf *MinMaxOf[T]) Clear() { sf.items = nil }
+
+// Add inserts the specified element into this priority queue.
+func (sf *MinMaxOf[T]) Add(val T) {
+	sf.items = append(sf.items, val)
+	sf.up(sf.Len() - 1)
+}
+
+// PeekMin retrieves, but does not remove, the least element of this priority queue,
+// or return the zero value if this priority queue is empty.
+func (sf *MinMaxOf[T]) PeekMin() (val T) {
+	if sf.Len() > 0 {
+		return sf.items[0]
+	}
+	return val
+}
+
+// PeekMax retrieves, but does not remove, the greatest element of this priority queue,
+// or return the zero value if this priority queue is empty.
+func (sf *MinMaxOf[T]) PeekMax() (val T) {
+	if sf.Len() > 0 {
+		return sf.items[sf.maxIndex()]
+	}
+	return val
+}
+
+// PollMin retrieves and removes the least element of this priority queue,
+// or return the zero value if this priority queue is empty.
+func (sf *MinMaxOf[T]) PollMin() (val T) {
+	if sf.Len() > 0 {
+		val = sf.removeAt(0)
+	}
+	return val
+}
+
+// PollMax retrieves and removes the greatest element of this priority queue,
+// or return the zero value if this priority queue is empty.
+func (sf *MinMaxOf[T]) PollMax() (val T) {
+	if sf.Len() > 0 {
+		val = sf.removeAt(sf.maxIndex())
+	}
+	return val
+}
+
+// All returns an iterator over the elements in this priority queue in ascending order,
+// it does not consume the queue, the elements are popped lazily from a copy of the heap.
+func (sf *MinMaxOf[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		h := &MinMaxOf[T]{items: append([]T(nil), sf.items...), cmp: sf.cmp}
+		for h.Len() > 0 {
+			if !yield(h.PollMin()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements in this priority queue in descending order,
+// it does not consume the queue, the elements are popped lazily from a copy of the heap.
+func (sf *MinMaxOf[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		h := &MinMaxOf[T]{items: append([]T(nil), sf.items...), cmp: sf.cmp}
+		for h.Len() > 0 {
+			if !yield(h.PollMax()) {
+				return
+			}
+		}
+	}
+}
+
+func (sf *MinMaxOf[T]) less(i, j int) bool {
+	return sf.cmp(sf.items[i], sf.items[j]) < 0
+}
+
+// lessOn compares i and j on the level of a node, it is less on the min levels and greater on the max levels.
+func (sf *MinMaxOf[T]) lessOn(minLevel bool, i, j int) bool {
+	if minLevel {
+		return sf.less(i, j)
+	}
+	return sf.less(j, i)
+}
+
+func (sf *MinMaxOf[T]) swap(i, j int) { sf.items[i], sf.items[j] = sf.items[j], sf.items[i] }
+
+// isMinLevel reports whether the index i is on a min level.
+func isMinLevel(i int) bool { return bits.Len(uint(i+1))%2 == 1 }
+
+// maxIndex returns the index of the greatest element, the heap must not be empty.
+func (sf *MinMaxOf[T]) maxIndex() int {
+	switch n := sf.Len(); {
+	case n == 1:
+		return 0
+	case n == 2 || !sf.less(1, 2):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// removeAt removes and returns the element with index i, i must be the index of the least or the greatest.
+func (sf *MinMaxOf[T]) removeAt(i int) T {
+	var zero T
+
+	n := sf.Len() - 1
+	val := sf.items[i]
+	sf.items[i] = sf.items[n]
+	sf.items[n] = zero // should set zero for gc
+	sf.items = sf.items[:n]
+	if i < n {
+		sf.down(i)
+	}
+	return val
+}
+
+func (sf *MinMaxOf[T]) up(i int) {
+	if i == 0 {
+		return
+	}
+	minLevel := isMinLevel(i)
+	if p := (i - 1) / 2; sf.lessOn(!minLevel, i, p) {
+		// it belongs to the levels of its parent.
+		sf.swap(i, p)
+		i, minLevel = p, !minLevel
+	}
+	// bubble up through the grandparents on the same kind of levels.
+	for i > 2 {
+		g := ((i-1)/2 - 1) / 2
+		if !sf.lessOn(minLevel, i, g) {
+			break
+		}
+		sf.swap(i, g)
+		i = g
+	}
+}
+
+func (sf *MinMaxOf[T]) down(i int) {
+	minLevel := isMinLevel(i)
+	n := sf.Len()
+	for {
+		// m is the extreme of the children and the grandchildren.
+		first := 2*i + 1
+		if first >= n {
+			return
+		}
+		m := first
+		for _, c := range []int{first + 1, 2*first + 1, 2*first + 2, 2*first + 3, 2*first + 4} {
+			if c < n && sf.lessOn(minLevel, c, m) {
+				m = c
+			}
+		}
+		if !sf.lessOn(minLevel, m, i) {
+			return
+		}
+		sf.swap(m, i)
+		if m <= first+1 { // a child
+			return
+		}
+		// a grandchild, keep the order with its parent.
+		if p := (m - 1) / 2; sf.lessOn(minLevel, p, m) {
+			sf.swap(m, p)
+		}
+		i = m
+	}
+}
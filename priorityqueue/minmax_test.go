@@ -0,0 +1,94 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinMax(t *testing.T) {
+	q := NewMinMax()
+	assert.True(t, q.IsEmpty())
+	assert.Nil(t, q.PeekMin())
+	assert.Nil(t, q.PeekMax())
+	assert.Nil(t, q.PollMin())
+	assert.Nil(t, q.PollMax())
+
+	for _, v := range []interface{}{15, 19, 12, 8, 13} {
+		q.Add(v)
+	}
+	assert.Equal(t, 5, q.Len())
+	assert.Equal(t, 8, q.PeekMin())
+	assert.Equal(t, 19, q.PeekMax())
+	assert.Equal(t, []interface{}{8, 12, 13, 15, 19}, slices.Collect(q.All()))
+	assert.Equal(t, []interface{}{19, 15, 13, 12, 8}, slices.Collect(q.Backward()))
+
+	assert.Equal(t, 19, q.PollMax())
+	assert.Equal(t, 8, q.PollMin())
+	assert.Equal(t, 15, q.PollMax())
+	assert.Equal(t, 12, q.PollMin())
+	assert.Equal(t, 13, q.PeekMin())
+	assert.Equal(t, 13, q.PeekMax())
+	assert.Equal(t, 13, q.PollMax())
+	assert.True(t, q.IsEmpty())
+
+	q.Add(1)
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+
+	// with comparator, reverse order
+	q = NewMinMax(WithComparator(&myInt{}))
+	for _, v := range []interface{}{15, 19, 12, 8, 13} {
+		q.Add(v)
+	}
+	assert.Equal(t, 19, q.PeekMin())
+	assert.Equal(t, 8, q.PeekMax())
+}
+
+func TestMinMaxRandom(t *testing.T) {
+	q := NewMinMaxOf[int](WithCompareFunc(func(v1, v2 int) int { return v1 - v2 }))
+	var want []int
+	for i := 0; i < 10000; i++ {
+		switch rand.Intn(4) {
+		case 0, 1:
+			v := rand.Intn(1000)
+			q.Add(v)
+			want = append(want, v)
+		case 2:
+			if len(want) > 0 {
+				require.Equal(t, want[0], q.PollMin())
+				want = want[1:]
+			}
+		case 3:
+			if len(want) > 0 {
+				require.Equal(t, want[len(want)-1], q.PollMax())
+				want = want[:len(want)-1]
+			}
+		}
+		sort.Ints(want)
+		require.Equal(t, len(want), q.Len())
+		if len(want) > 0 {
+			require.Equal(t, want[0], q.PeekMin())
+			require.Equal(t, want[len(want)-1], q.PeekMax())
+		}
+	}
+	assert.Equal(t, want, slices.Collect(q.All()))
+}
@@ -0,0 +1,203 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityqueue
+
+type pairingNode[T any] struct {
+	val T
+	seq uint64
+	// child is the leftmost child, sibling is the right sibling,
+	// prev is the left sibling, or the parent if it is the leftmost child.
+	child, sibling, prev *pairingNode[T]
+}
+
+// pairingHeap is the pairing heap of T elements, push and merge are O(1),
+// pop is O(log n) amortized.
+type pairingHeap[T any] struct {
+	order[T]
+	root *pairingNode[T]
+	size int
+}
+
+// meld links the two roots a and b, it returns the new root.
+func (h *pairingHeap[T]) meld(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.less(b.val, b.seq, a.val, a.seq) {
+		a, b = b, a
+	}
+	// b becomes the leftmost child of a
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	a.sibling, a.prev = nil, nil
+	return a
+}
+
+// mergePairs melds the sibling list starting from first by the two-pass pairing, it returns the new root.
+func (h *pairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	// first pass: meld the pairs from left to right
+	var pairs []*pairingNode[T]
+	for first != nil {
+		a, b := first, first.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+		}
+		a.sibling, a.prev = nil, nil
+		if b != nil {
+			b.sibling, b.prev = nil, nil
+		}
+		pairs = append(pairs, h.meld(a, b))
+	}
+	// second pass: meld them from right to left
+	var root *pairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.meld(pairs[i], root)
+	}
+	return root
+}
+
+func (h *pairingHeap[T]) Len() int { return h.size }
+
+func (h *pairingHeap[T]) push(v T) {
+	h.pushSeq(v, h.seq())
+}
+
+func (h *pairingHeap[T]) pushSeq(v T, seq uint64) {
+	h.root = h.meld(h.root, &pairingNode[T]{val: v, seq: seq})
+	h.size++
+}
+
+func (h *pairingHeap[T]) peek() T { return h.root.val }
+
+func (h *pairingHeap[T]) pop() T {
+	n := h.root
+	h.root = h.mergePairs(n.child)
+	h.size--
+	return n.val
+}
+
+func (h *pairingHeap[T]) removeFunc(f func(T) bool) bool {
+	var found *pairingNode[T]
+	h.walkNode(func(n *pairingNode[T]) bool {
+		if f(n.val) {
+			found = n
+			return false
+		}
+		return true
+	})
+	if found == nil {
+		return false
+	}
+	if found == h.root {
+		h.pop()
+		return true
+	}
+	// detach the subtree from its parent or left sibling
+	if found.prev.child == found {
+		found.prev.child = found.sibling
+	} else {
+		found.prev.sibling = found.sibling
+	}
+	if found.sibling != nil {
+		found.sibling.prev = found.prev
+	}
+	found.sibling, found.prev = nil, nil
+	h.root = h.meld(h.root, h.mergePairs(found.child))
+	h.size--
+	return true
+}
+
+// walkNode calls f for every node in depth-first order, until f returns false.
+func (h *pairingHeap[T]) walkNode(f func(n *pairingNode[T]) bool) {
+	if h.root == nil {
+		return
+	}
+	stack := []*pairingNode[T]{h.root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !f(n) {
+			return
+		}
+		if n.sibling != nil {
+			stack = append(stack, n.sibling)
+		}
+		if n.child != nil {
+			stack = append(stack, n.child)
+		}
+	}
+}
+
+func (h *pairingHeap[T]) pushAll(vals []T) {
+	for _, v := range vals {
+		h.push(v)
+	}
+}
+
+// removeIf rebuilds the heap with the remaining nodes in O(n).
+func (h *pairingHeap[T]) removeIf(f func(T) bool) int {
+	var remains []*pairingNode[T]
+	h.walkNode(func(n *pairingNode[T]) bool {
+		if !f(n.val) {
+			remains = append(remains, n)
+		}
+		return true
+	})
+	removed := h.size - len(remains)
+	if removed > 0 {
+		h.root, h.size = nil, 0
+		for _, n := range remains {
+			h.pushSeq(n.val, n.seq)
+		}
+	}
+	return removed
+}
+
+func (h *pairingHeap[T]) walk(f func(T) bool) {
+	h.walkNode(func(n *pairingNode[T]) bool { return f(n.val) })
+}
+
+func (h *pairingHeap[T]) reset(items []T) {
+	h.root, h.size, h.next = nil, 0, 0
+	for _, v := range items {
+		h.push(v)
+	}
+}
+
+func (h *pairingHeap[T]) clone() backend[T] {
+	c := &pairingHeap[T]{order: h.order}
+	h.walkNode(func(n *pairingNode[T]) bool {
+		c.pushSeq(n.val, n.seq)
+		return true
+	})
+	return c
+}
+
+// merge moves all the elements of other into this heap in O(1), other becomes empty.
+func (h *pairingHeap[T]) merge(other *pairingHeap[T]) {
+	h.root = h.meld(h.root, other.root)
+	h.size += other.size
+	h.next = max(h.next, other.next)
+	other.root, other.size = nil, 0
+}
@@ -18,7 +18,8 @@
 package priorityqueue
 
 import (
-	"container/heap"
+	"iter"
+	"slices"
 
 	"github.com/thinkgos/container"
 	"github.com/thinkgos/container/comparator"
@@ -26,91 +27,314 @@ import (
 
 var _ container.Queue = (*Queue)(nil)
 
-// Queue represents an unbounded priority queue based on a priority heap.
-// It implements heap.Interface.
-type Queue struct {
-	ctn *comparator.Container
+// Queue represents an unbounded priority queue of interface{} elements based on a priority heap.
+type Queue = QueueOf[interface{}]
+
+// QueueOf represents an unbounded priority queue of T elements based on a priority heap.
+// The heap is a binary heap by default, see WithBackend for the others.
+// The zero value is only usable as the target of decoding, use NewOf to create a QueueOf.
+type QueueOf[T any] struct {
+	ctn      backend[T]
+	capacity int
+}
+
+// Backend is the heap implementation of the priority queue.
+type Backend int
+
+// Backend of the priority queue.
+const (
+	// BinaryHeap is the binary heap on a slice, the default one.
+	BinaryHeap Backend = iota
+	// DAryHeap is the d-ary heap on a slice, a node has d children, see WithArity.
+	// It has a shallower tree than the binary heap, Add is faster, Poll does more comparisons per level.
+	DAryHeap
+	// PairingHeap is the pairing heap, Add and Merge are O(1), Poll is O(log n) amortized.
+	PairingHeap
+	// FibonacciHeap is the Fibonacci heap, Add and Merge are O(1), Poll is O(log n) amortized.
+	FibonacciHeap
+)
+
+type options struct {
+	cmp      comparator.Comparator
+	maxHeap  bool
+	stable   bool
+	capacity int
+	backend  Backend
+	arity    int
 }
 
 // Option option for New.
-type Option func(q *Queue)
+type Option func(o *options)
 
 // WithComparator with user's Comparator.
 func WithComparator(c comparator.Comparator) Option {
-	return func(q *Queue) { q.ctn.Cmp = c }
+	return func(o *options) { o.cmp = c }
+}
+
+// WithCompareFunc with user's CompareFunc of T elements.
+func WithCompareFunc[T any](f comparator.CompareFunc[T]) Option {
+	return WithComparator(f)
 }
 
 // WithMaxHeap with max heap.
 func WithMaxHeap(b bool) Option {
-	return func(q *Queue) {
-		q.ctn.Reverse = b
+	return func(o *options) {
+		o.maxHeap = b
+	}
+}
+
+// WithStable with stable ordering, the equal elements are retrieved in their insertion order (FIFO),
+// a monotonically increasing sequence number is attached to every element as a tiebreaker.
+func WithStable(b bool) Option {
+	return func(o *options) {
+		o.stable = b
+	}
+}
+
+// WithCapacity with the maximum number of elements, it makes a bounded top-K priority queue.
+// Adding to a full queue compares the element with the head, the head is evicted if the element
+// is greater in the heap order, otherwise the element is dropped. So a min heap keeps the k greatest
+// elements, and a max heap keeps the k least elements. Zero or negative means unbounded.
+func WithCapacity(k int) Option {
+	return func(o *options) {
+		o.capacity = max(k, 0)
+	}
+}
+
+// WithBackend with the heap implementation, default BinaryHeap.
+func WithBackend(b Backend) Option {
+	return func(o *options) {
+		o.backend = b
+	}
+}
+
+// WithArity with the number of children of a node for DAryHeap, default 4.
+func WithArity(d int) Option {
+	return func(o *options) {
+		o.arity = d
 	}
 }
 
 // New initializes and returns an Queue, default min heap.
 func New(opts ...Option) *Queue {
-	q := &Queue{new(comparator.Container)}
+	return NewOf[interface{}](opts...)
+}
+
+// NewOf initializes and returns an QueueOf of T elements, default min heap.
+// It panics if there is no comparator and T has no natural ordering, see comparator.NaturalOf.
+func NewOf[T any](opts ...Option) *QueueOf[T] {
+	o := options{}
 	for _, opt := range opts {
-		opt(q)
+		opt(&o)
+	}
+	ord := newOrder(comparator.FuncOf[T](o.cmp), o.maxHeap, o.stable)
+	var ctn backend[T]
+	switch o.backend {
+	case DAryHeap:
+		arity := o.arity
+		if arity < 2 {
+			arity = 4
+		}
+		ctn = &heapData[T]{order: ord, arity: arity}
+	case PairingHeap:
+		ctn = &pairingHeap[T]{order: ord}
+	case FibonacciHeap:
+		ctn = &fibHeap[T]{order: ord}
+	default:
+		ctn = &heapData[T]{order: ord, arity: 2}
 	}
+	return &QueueOf[T]{ctn: ctn, capacity: o.capacity}
+}
+
+// NewFrom initializes and returns an Queue with the values, the heap is built in O(n).
+func NewFrom(values []interface{}, opts ...Option) *Queue {
+	return NewFromOf(values, opts...)
+}
+
+// NewFromOf initializes and returns an QueueOf of T elements with the values, the heap is built in O(n).
+// If the queue is bounded, only the greatest values in the heap order are kept, see WithCapacity.
+func NewFromOf[T any](values []T, opts ...Option) *QueueOf[T] {
+	q := NewOf[T](opts...)
+	q.ctn.reset(append([]T(nil), values...))
+	q.trim()
 	return q
 }
 
 // Len returns the length of this priority queue.
-func (sf *Queue) Len() int { return sf.ctn.Len() }
+func (sf *QueueOf[T]) Len() int { return sf.ctn.Len() }
 
 // IsEmpty returns true if this list contains no elements.
-func (sf *Queue) IsEmpty() bool { return sf.Len() == 0 }
+func (sf *QueueOf[T]) IsEmpty() bool { return sf.Len() == 0 }
 
 // Clear removes all of the elements from this priority queue.
-func (sf *Queue) Clear() { sf.ctn.Items = nil }
+func (sf *QueueOf[T]) Clear() { sf.ctn.reset(nil) }
+
+// Cap returns the capacity of this priority queue, zero means unbounded.
+func (sf *QueueOf[T]) Cap() int { return sf.capacity }
 
 // Add inserts the specified element into this priority queue.
-func (sf *Queue) Add(items interface{}) {
-	heap.Push(sf.ctn, items)
+// If this queue is bounded and full, it evicts the head or drops the element, see WithCapacity.
+func (sf *QueueOf[T]) Add(items T) {
+	if sf.capacity > 0 && sf.Len() >= sf.capacity {
+		if sf.Len() == 0 || sf.ctn.ordering().compare(items, sf.ctn.peek()) <= 0 {
+			return
+		}
+		sf.ctn.pop()
+	}
+	sf.ctn.push(items)
 }
 
-// Peek retrieves, but does not remove, the head of this queue, or return nil if this queue is empty.
-func (sf *Queue) Peek() interface{} {
+// AddAll inserts all the elements into this priority queue.
+// If this queue is unbounded and there are many of them, the heap is re-established once in O(n).
+func (sf *QueueOf[T]) AddAll(vals ...T) {
+	if sf.capacity > 0 {
+		for _, v := range vals {
+			sf.Add(v)
+		}
+		return
+	}
+	sf.ctn.pushAll(vals)
+}
+
+// Peek retrieves, but does not remove, the head of this queue, or return the zero value if this queue is empty.
+func (sf *QueueOf[T]) Peek() (val T) {
 	if sf.Len() > 0 {
-		return sf.ctn.Items[0]
+		return sf.ctn.peek()
 	}
-	return nil
+	return val
 }
 
-// Poll retrieves and removes the head of the this queue, or return nil if this queue is empty.
-func (sf *Queue) Poll() interface{} {
+// Poll retrieves and removes the head of the this queue, or return the zero value if this queue is empty.
+func (sf *QueueOf[T]) Poll() (val T) {
 	if sf.Len() > 0 {
-		return heap.Pop(sf.ctn)
+		val = sf.ctn.pop()
+	}
+	return val
+}
+
+// PollN retrieves and removes at most n elements from the head of this queue in order.
+func (sf *QueueOf[T]) PollN(n int) []T {
+	n = min(n, sf.Len())
+	if n <= 0 {
+		return nil
 	}
-	return nil
+	vals := make([]T, 0, n)
+	for ; n > 0; n-- {
+		vals = append(vals, sf.ctn.pop())
+	}
+	return vals
 }
 
 // Contains returns true if this queue contains the specified element.
-func (sf *Queue) Contains(val interface{}) bool { return sf.indexOf(val) >= 0 }
+func (sf *QueueOf[T]) Contains(val T) bool {
+	found := false
+	if sf.Len() > 0 && any(val) != nil {
+		ord := sf.ctn.ordering()
+		sf.ctn.walk(func(v T) bool {
+			found = ord.equal(val, v)
+			return !found
+		})
+	}
+	return found
+}
 
 // Remove a single instance of the specified element from this queue, if it is present.
-// It returns false if the target value isn't present, otherwise returns true.
-func (sf *Queue) Remove(val interface{}) {
-	if idx := sf.indexOf(val); idx >= 0 {
-		heap.Remove(sf.ctn, idx)
+func (sf *QueueOf[T]) Remove(val T) {
+	if sf.Len() > 0 && any(val) != nil {
+		ord := sf.ctn.ordering()
+		sf.ctn.removeFunc(func(v T) bool { return ord.equal(val, v) })
+	}
+}
+
+// RemoveIf removes all of the elements of this queue that satisfy the given predicate,
+// the heap is re-established once. It returns the number of the removed elements.
+func (sf *QueueOf[T]) RemoveIf(pred func(T) bool) int {
+	if sf.Len() == 0 {
+		return 0
 	}
+	return sf.ctn.removeIf(pred)
 }
 
-func (sf *Queue) indexOf(val interface{}) int {
-	if sf.Len() > 0 && val != nil {
-		for i := 0; i < sf.Len(); i++ {
-			if sf.compare(val, sf.ctn.Items[i]) {
-				return i
+// Merge moves all the elements of other into this priority queue, other becomes empty.
+// It is O(1) if both queues are PairingHeap or FibonacciHeap, otherwise the elements are added one by one.
+// The two queues should have the same ordering, the order among equal elements of the two queues
+// in stable mode is unspecified.
+func (sf *QueueOf[T]) Merge(other *QueueOf[T]) {
+	if other == nil || other == sf {
+		return
+	}
+	switch h := sf.ctn.(type) {
+	case *pairingHeap[T]:
+		if o, ok := other.ctn.(*pairingHeap[T]); ok {
+			h.merge(o)
+			sf.trim()
+			return
+		}
+	case *fibHeap[T]:
+		if o, ok := other.ctn.(*fibHeap[T]); ok {
+			h.merge(o)
+			sf.trim()
+			return
+		}
+	}
+	for other.Len() > 0 {
+		sf.Add(other.ctn.pop())
+	}
+}
+
+// Iterator calls f for every element in this priority queue in no particular order, until f returns false.
+// It does not consume the queue and takes no extra memory for the slice based heaps, use All for sorted order.
+func (sf *QueueOf[T]) Iterator(f func(T) bool) {
+	if f != nil {
+		sf.ctn.walk(f)
+	}
+}
+
+// Values returns a copy of all the elements in no particular order, use Sorted for sorted order.
+func (sf *QueueOf[T]) Values() []T {
+	values := make([]T, 0, sf.Len())
+	sf.ctn.walk(func(v T) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+// All returns an iterator over the elements in this priority queue in sorted order,
+// it does not consume the queue, the elements are popped lazily from a copy of the heap.
+func (sf *QueueOf[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		h := sf.ctn.clone()
+		for h.Len() > 0 {
+			if !yield(h.pop()) {
+				return
 			}
 		}
 	}
-	return -1
 }
 
-func (sf *Queue) compare(v1, v2 interface{}) bool {
-	if sf.ctn.Cmp != nil {
-		return sf.ctn.Cmp.Compare(v1, v2) == 0
+// Backward returns an iterator over the elements in this priority queue in reverse sorted order,
+// it does not consume the queue.
+func (sf *QueueOf[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		items := sf.Sorted()
+		for i := len(items) - 1; i >= 0; i-- {
+			if !yield(items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns the elements of this priority queue in sorted order, it does not consume the queue.
+func (sf *QueueOf[T]) Sorted() []T {
+	items := make([]T, 0, sf.Len())
+	return slices.AppendSeq(items, sf.All())
+}
+
+// trim drops the heads exceeding the capacity.
+func (sf *QueueOf[T]) trim() {
+	for sf.capacity > 0 && sf.Len() > sf.capacity {
+		sf.ctn.pop()
 	}
-	return v1 == v2
 }
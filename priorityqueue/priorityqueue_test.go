@@ -15,10 +15,14 @@
 package priorityqueue
 
 import (
+	"math/rand"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
 )
 
 func TestPQLen(t *testing.T) {
@@ -223,3 +227,260 @@ func (s *student) Compare(v1, v2 interface{}) int {
 	}
 	return 0
 }
+
+func TestPQOf(t *testing.T) {
+	var q container.QueueOf[int] = NewOf[int](WithMaxHeap(true))
+	for _, v := range []int{15, 19, 12, 8, 13} {
+		q.Add(v)
+	}
+	require.Equal(t, 5, q.Len())
+	require.Equal(t, 19, q.Peek())
+	require.True(t, q.Contains(12))
+	q.Remove(12)
+	require.False(t, q.Contains(12))
+
+	for _, v := range []int{19, 15, 13, 8} {
+		require.Equal(t, v, q.Poll())
+	}
+	require.Zero(t, q.Poll())
+	require.Zero(t, q.Peek())
+
+	// with compare function
+	pq := NewOf[*student](WithCompareFunc(func(s1, s2 *student) int { return s1.age - s2.age }))
+	pq.Add(&student{name: "benjamin", age: 34})
+	pq.Add(&student{name: "alice", age: 21})
+	pq.Add(&student{name: "john", age: 42})
+	require.Equal(t, "alice", pq.Poll().name)
+	require.Equal(t, "benjamin", pq.Poll().name)
+	require.Equal(t, "john", pq.Poll().name)
+	require.Nil(t, pq.Poll())
+
+	// the named types have the natural ordering, a struct needs a comparator at construction
+	type priority int
+	nq := NewOf[priority]()
+	nq.Add(2)
+	nq.Add(1)
+	require.Equal(t, priority(1), nq.Poll())
+	require.Panics(t, func() { NewOf[student]() })
+}
+
+func TestPQAll(t *testing.T) {
+	q := NewOf[int]()
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		q.Add(v)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(q.All()))
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, slices.Collect(q.Backward()))
+	assert.Equal(t, 5, q.Len())
+	assert.Equal(t, 1, q.Peek())
+
+	var got []int
+	for v := range q.All() {
+		if v > 2 {
+			break
+		}
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{1, 2}, got)
+
+	q = NewOf[int](WithMaxHeap(true))
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		q.Add(v)
+	}
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, slices.Collect(q.All()))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(q.Backward()))
+}
+
+func TestPQStable(t *testing.T) {
+	type job struct {
+		name     string
+		priority int
+	}
+	byPriority := WithCompareFunc(func(j1, j2 job) int { return j1.priority - j2.priority })
+	jobs := []job{{"a", 2}, {"b", 1}, {"c", 2}, {"d", 1}, {"e", 2}, {"f", 1}, {"g", 3}, {"h", 2}}
+
+	collect := func(q *QueueOf[job]) string {
+		s := ""
+		for !q.IsEmpty() {
+			s += q.Poll().name
+		}
+		return s
+	}
+
+	q := NewOf[job](byPriority, WithStable(true))
+	for _, j := range jobs {
+		q.Add(j)
+	}
+	assert.Equal(t, "b", q.Peek().name)
+	assert.True(t, q.Contains(job{"x", 3}))
+	var names string
+	for j := range q.All() {
+		names += j.name
+	}
+	assert.Equal(t, "bdfacehg", names)
+	assert.Equal(t, "bdfacehg", collect(q))
+
+	q = NewOf[job](byPriority, WithStable(true), WithMaxHeap(true))
+	for _, j := range jobs {
+		q.Add(j)
+	}
+	q.Remove(job{"", 3})
+	assert.Equal(t, "acehbdf", collect(q))
+
+	// keep FIFO after interleaved polls and clear
+	q = NewOf[job](byPriority, WithStable(true))
+	q.Add(job{"x", 1})
+	q.Clear()
+	for i, j := range jobs {
+		q.Add(j)
+		if i == 3 {
+			assert.Equal(t, "b", q.Poll().name)
+		}
+	}
+	assert.Equal(t, "dfacehg", collect(q))
+}
+
+func TestPQCapacity(t *testing.T) {
+	// keep the 3 greatest
+	q := NewOf[int](WithCapacity(3))
+	assert.Equal(t, 3, q.Cap())
+	for _, v := range []int{5, 1, 9, 4, 7, 3, 8} {
+		q.Add(v)
+	}
+	assert.Equal(t, 3, q.Len())
+	assert.Equal(t, []int{7, 8, 9}, q.Sorted())
+	assert.Equal(t, 7, q.Peek())
+	assert.Equal(t, 3, q.Len())
+
+	// keep the 3 least
+	q = NewOf[int](WithCapacity(3), WithMaxHeap(true))
+	for _, v := range []int{5, 1, 9, 4, 7, 3, 8} {
+		q.Add(v)
+	}
+	assert.Equal(t, []int{4, 3, 1}, q.Sorted())
+
+	// with comparator, reverse order keeps the 2 least
+	pq := New(WithComparator(&myInt{}), WithCapacity(2))
+	for _, v := range []interface{}{15, 19, 12, 8, 13} {
+		pq.Add(v)
+	}
+	assert.Equal(t, []interface{}{12, 8}, pq.Sorted())
+
+	// unbounded
+	q = NewOf[int](WithCapacity(-1))
+	assert.Zero(t, q.Cap())
+	for _, v := range []int{3, 1, 2} {
+		q.Add(v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, q.Sorted())
+	assert.Equal(t, 3, q.Len())
+
+	// decoding more elements than the capacity keeps the greatest
+	data, err := q.MarshalBinary()
+	require.NoError(t, err)
+	got := NewOf[int](WithCapacity(2))
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, []int{2, 3}, got.Sorted())
+}
+
+func TestPQCapacityStable(t *testing.T) {
+	type job struct {
+		name     string
+		priority int
+	}
+	q := NewOf[job](WithCompareFunc(func(j1, j2 job) int { return j1.priority - j2.priority }),
+		WithCapacity(3), WithStable(true), WithMaxHeap(true))
+	for _, j := range []job{{"a", 1}, {"b", 2}, {"c", 1}, {"d", 3}, {"e", 2}} {
+		q.Add(j)
+	}
+	var names string
+	for _, j := range q.Sorted() {
+		names += j.name
+	}
+	assert.Equal(t, "bac", names)
+}
+
+func TestPQBulk(t *testing.T) {
+	for _, b := range testBackends {
+		t.Run(b.name, func(t *testing.T) {
+			values := []int{9, 3, 7, 1, 8, 2, 6, 4, 5, 0}
+			q := NewFromOf(values, b.opts...)
+			assert.Equal(t, []int{9, 3, 7, 1, 8, 2, 6, 4, 5, 0}, values, "values should not be modified")
+			assert.Equal(t, 10, q.Len())
+			got := q.Values()
+			slices.Sort(got)
+			assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, got)
+
+			n := 0
+			q.Iterator(func(int) bool {
+				n++
+				return n < 3
+			})
+			assert.Equal(t, 3, n)
+			q.Iterator(nil)
+
+			assert.Equal(t, []int{0, 1, 2}, q.PollN(3))
+			assert.Nil(t, q.PollN(0))
+
+			assert.Equal(t, 3, q.RemoveIf(func(v int) bool { return v%3 == 0 }))
+			assert.Zero(t, q.RemoveIf(func(v int) bool { return v > 100 }))
+			assert.Equal(t, []int{4, 5, 7, 8}, q.Sorted())
+
+			q.AddAll(10, 1, 6)
+			q.AddAll(3)
+			q.AddAll()
+			assert.Equal(t, []int{1, 3, 4, 5, 6, 7, 8, 10}, q.PollN(100))
+			assert.True(t, q.IsEmpty())
+			assert.Zero(t, q.RemoveIf(func(int) bool { return true }))
+
+			// bounded
+			q = NewFromOf(values, append(b.opts, WithCapacity(3))...)
+			assert.Equal(t, []int{7, 8, 9}, q.Sorted())
+			q.AddAll(10, 0, 11)
+			assert.Equal(t, []int{9, 10, 11}, q.Sorted())
+		})
+	}
+
+	q := NewFrom([]interface{}{3, 1, 2}, WithMaxHeap(true))
+	assert.Equal(t, []interface{}{3, 2, 1}, q.PollN(3))
+
+	// stable
+	type job struct {
+		name     string
+		priority int
+	}
+	jq := NewFromOf([]job{{"a", 1}, {"b", 0}, {"c", 1}}, WithStable(true),
+		WithCompareFunc(func(j1, j2 job) int { return j1.priority - j2.priority }))
+	jq.AddAll(job{"d", 0}, job{"e", 1})
+	jq.RemoveIf(func(j job) bool { return j.name == "c" })
+	names := ""
+	for _, j := range jq.PollN(10) {
+		names += j.name
+	}
+	assert.Equal(t, "bdae", names)
+}
+
+func BenchmarkPQNewFrom(b *testing.B) {
+	values := make([]int, 100000)
+	for i := range values {
+		values[i] = rand.Int()
+	}
+	b.Run("NewFrom", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewFromOf(values)
+		}
+	})
+	b.Run("AddAll", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewOf[int]().AddAll(values...)
+		}
+	})
+	b.Run("Add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := NewOf[int]()
+			for _, v := range values {
+				q.Add(v)
+			}
+		}
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"slices"
+
+	"github.com/thinkgos/container"
+)
+
+// MarshalJSON implement json.Marshaler, the elements are encoded as a JSON array from head to tail.
+// The elements of interface type are encoded as container.JSONValue.
+func (sf *QueueOf[T]) MarshalJSON() ([]byte, error) {
+	return container.MarshalJSONValues(slices.Collect(sf.All()))
+}
+
+// UnmarshalJSON implement json.Unmarshaler, it replaces the elements of this queue, the comparator is kept.
+func (sf *QueueOf[T]) UnmarshalJSON(data []byte) error {
+	values, err := container.UnmarshalJSONValues[T](data)
+	if err != nil {
+		return err
+	}
+	sf.reset(values)
+	return nil
+}
+
+// MarshalBinary implement encoding.BinaryMarshaler, the elements are encoded by gob from head to tail.
+func (sf *QueueOf[T]) MarshalBinary() ([]byte, error) {
+	return container.GobEncode(slices.Collect(sf.All()))
+}
+
+// UnmarshalBinary implement encoding.BinaryUnmarshaler, it replaces the elements of this queue, the comparator is kept.
+func (sf *QueueOf[T]) UnmarshalBinary(data []byte) error {
+	var values []T
+	if err := container.GobDecode(data, &values); err != nil {
+		return err
+	}
+	sf.reset(values)
+	return nil
+}
+
+// GobEncode implement gob.GobEncoder.
+func (sf *QueueOf[T]) GobEncode() ([]byte, error) { return sf.MarshalBinary() }
+
+// GobDecode implement gob.GobDecoder.
+func (sf *QueueOf[T]) GobDecode(data []byte) error { return sf.UnmarshalBinary(data) }
+
+func (sf *QueueOf[T]) reset(values []T) {
+	sf.Clear()
+	for _, v := range values {
+		sf.Add(v)
+	}
+}
+
+// MarshalJSON implement json.Marshaler, the elements are encoded as a JSON array from head to tail.
+// The elements of interface type are encoded as container.JSONValue.
+func (sf *QuickQueueOf[T]) MarshalJSON() ([]byte, error) {
+	return container.MarshalJSONValues(slices.Collect(sf.All()))
+}
+
+// UnmarshalJSON implement json.Unmarshaler, it replaces the elements of this queue, the comparator is kept.
+func (sf *QuickQueueOf[T]) UnmarshalJSON(data []byte) error {
+	values, err := container.UnmarshalJSONValues[T](data)
+	if err != nil {
+		return err
+	}
+	sf.head, sf.tail, sf.headPos = nil, values, 0
+	return nil
+}
+
+// MarshalBinary implement encoding.BinaryMarshaler, the elements are encoded by gob from head to tail.
+func (sf *QuickQueueOf[T]) MarshalBinary() ([]byte, error) {
+	return container.GobEncode(slices.Collect(sf.All()))
+}
+
+// UnmarshalBinary implement encoding.BinaryUnmarshaler, it replaces the elements of this queue, the comparator is kept.
+func (sf *QuickQueueOf[T]) UnmarshalBinary(data []byte) error {
+	var values []T
+	if err := container.GobDecode(data, &values); err != nil {
+		return err
+	}
+	sf.head, sf.tail, sf.headPos = nil, values, 0
+	return nil
+}
+
+// GobEncode implement gob.GobEncoder.
+func (sf *QuickQueueOf[T]) GobEncode() ([]byte, error) { return sf.MarshalBinary() }
+
+// GobDecode implement gob.GobDecoder.
+func (sf *QuickQueueOf[T]) GobDecode(data []byte) error { return sf.UnmarshalBinary(data) }
@@ -0,0 +1,102 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueCodec(t *testing.T) {
+	q := New()
+	q.Add(0)
+	q.Add(1)
+	q.Add("hello")
+	q.Add(int64(2))
+	q.Add(nil)
+	q.Poll()
+
+	data, err := json.Marshal(q)
+	require.NoError(t, err)
+	got := New()
+	got.Add("replaced")
+	require.NoError(t, json.Unmarshal(data, got))
+	assert.Equal(t, slices.Collect(q.All()), slices.Collect(got.All()))
+	assert.Equal(t, 4, got.Len())
+
+	data, err = q.MarshalBinary()
+	require.NoError(t, err)
+	got = New()
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, slices.Collect(q.All()), slices.Collect(got.All()))
+
+	// gob with the zero value
+	qi := NewOf[int]()
+	qi.Add(3)
+	qi.Add(1)
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(qi))
+	var gotInt QueueOf[int]
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&gotInt))
+	assert.Equal(t, 3, gotInt.Poll())
+	assert.Equal(t, 1, gotInt.Poll())
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), got))
+	assert.Error(t, got.UnmarshalBinary([]byte("bad")))
+}
+
+func TestQuickQueueCodec(t *testing.T) {
+	q := NewQuickQueue()
+	q.Add(0)
+	q.Add(1)
+	q.Add("hello")
+	q.Poll()
+	q.Add(int64(2))
+	q.Add(nil)
+
+	data, err := json.Marshal(q)
+	require.NoError(t, err)
+	got := NewQuickQueue()
+	got.Add("replaced")
+	require.NoError(t, json.Unmarshal(data, got))
+	assert.Equal(t, slices.Collect(q.All()), slices.Collect(got.All()))
+	assert.Equal(t, 4, got.Len())
+
+	data, err = q.MarshalBinary()
+	require.NoError(t, err)
+	got = NewQuickQueue()
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, slices.Collect(q.All()), slices.Collect(got.All()))
+
+	// gob with the zero value
+	qi := NewQuickQueueOf[int]()
+	qi.Add(3)
+	qi.Add(1)
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(qi))
+	var gotInt QuickQueueOf[int]
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&gotInt))
+	assert.Equal(t, 3, gotInt.Poll())
+	assert.Equal(t, 1, gotInt.Poll())
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), got))
+	assert.Error(t, got.UnmarshalBinary([]byte("bad")))
+}
@@ -18,3 +18,8 @@ func WithComparator(c comparator.Comparator) Option {
 		a.apply(c)
 	}
 }
+
+// WithCompareFunc with user's CompareFunc of T elements.
+func WithCompareFunc[T any](f comparator.CompareFunc[T]) Option {
+	return WithComparator(f)
+}
@@ -15,49 +15,60 @@
 package queue
 
 import (
+	"fmt"
+	"iter"
+
 	"github.com/thinkgos/container"
 	"github.com/thinkgos/container/comparator"
 )
 
-var _ container.Queue = (*Queue)(nil)
+var _ container.ExtendedQueue = (*Queue)(nil)
 
 // element is an element of the Queue implement with list.
-type element struct {
-	next  *element
-	value interface{}
+type element[T any] struct {
+	next  *element[T]
+	value T
 }
 
-// Queue represents a singly linked list.
-type Queue struct {
-	head   *element
-	tail   *element
+// Queue represents a singly linked list of interface{} elements.
+type Queue = QueueOf[interface{}]
+
+// QueueOf represents a singly linked list of T elements.
+type QueueOf[T any] struct {
+	head   *element[T]
+	tail   *element[T]
 	length int
-	cmp    comparator.Comparator
+	cmp    comparator.CompareFunc[T]
 }
 
 // New creates a Queue. which implement queue.Interface.
 func New(opts ...Option) *Queue {
-	q := new(Queue)
+	return NewOf[interface{}](opts...)
+}
+
+// NewOf creates a QueueOf of T elements. which implement queue.Interface.
+func NewOf[T any](opts ...Option) *QueueOf[T] {
+	q := new(QueueOf[T])
 	for _, opt := range opts {
 		opt(q)
 	}
 	return q
 }
 
-func (sf *Queue) apply(c comparator.Comparator) { sf.cmp = c }
+func (sf *QueueOf[T]) apply(c comparator.Comparator) { sf.cmp = comparator.FuncOf[T](c) }
 
 // Len returns the length of this queue.
-func (sf *Queue) Len() int { return sf.length }
+func (sf *QueueOf[T]) Len() int { return sf.length }
 
 // IsEmpty returns true if this Queue contains no elements.
-func (sf *Queue) IsEmpty() bool { return sf.Len() == 0 }
+func (sf *QueueOf[T]) IsEmpty() bool { return sf.Len() == 0 }
 
 // Clear initializes or clears queue.
-func (sf *Queue) Clear() { sf.head, sf.tail, sf.length = nil, nil, 0 }
+func (sf *QueueOf[T]) Clear() { sf.head, sf.tail, sf.length = nil, nil, 0 }
 
 // Add items to the queue.
-func (sf *Queue) Add(v interface{}) {
-	e := &element{value: v}
+func (sf *QueueOf[T]) Add(v T) {
+	e := &element[T]{value: v}
 	if sf.tail == nil {
 		sf.head, sf.tail = e, e
 	} else {
@@ -67,18 +78,16 @@ func (sf *Queue) Add(v interface{}) {
 	sf.length++
 }
 
-// Peek retrieves, but does not remove, the head of this Queue, or return nil if this Queue is empty.
-func (sf *Queue) Peek() interface{} {
+// Peek retrieves, but does not remove, the head of this Queue, or return the zero value if this Queue is empty.
+func (sf *QueueOf[T]) Peek() (val T) {
 	if sf.head != nil {
-		return sf.head.value
+		val = sf.head.value
 	}
-	return nil
+	return val
 }
 
-// Poll retrieves and removes the head of the this Queue, or return nil if this Queue is empty.
-func (sf *Queue) Poll() interface{} {
-	var val interface{}
-
+// Poll retrieves and removes the head of the this Queue, or return the zero value if this Queue is empty.
+func (sf *QueueOf[T]) Poll() (val T) {
 	if sf.head != nil {
 		val = sf.head.value
 		sf.head = sf.head.next
@@ -91,7 +100,7 @@ func (sf *Queue) Poll() interface{} {
 }
 
 // Contains returns true if this queue contains the specified element.
-func (sf *Queue) Contains(val interface{}) bool {
+func (sf *QueueOf[T]) Contains(val T) bool {
 	for e := sf.head; e != nil; e = e.next {
 		if sf.compare(val, e.value) {
 			return true
@@ -101,7 +110,7 @@ func (sf *Queue) Contains(val interface{}) bool {
 }
 
 // Remove a single instance of the specified element from this queue, if it is present.
-func (sf *Queue) Remove(val interface{}) {
+func (sf *QueueOf[T]) Remove(val T) {
 	for pre, e := sf.head, sf.head; e != nil; {
 		if sf.compare(val, e.value) {
 			if sf.head == e && sf.tail == e {
@@ -123,9 +132,91 @@ func (sf *Queue) Remove(val interface{}) {
 	}
 }
 
-func (sf *Queue) compare(v1, v2 interface{}) bool {
+// AddAll inserts the elements into the tail of this queue in order.
+func (sf *QueueOf[T]) AddAll(vals ...T) {
+	for _, v := range vals {
+		sf.Add(v)
+	}
+}
+
+// Get returns the element at the specified position from the head. The index must be in the range of [0, size).
+// The complexity is O(n).
+func (sf *QueueOf[T]) Get(index int) (T, error) {
+	if index < 0 || index >= sf.length {
+		var zero T
+		return zero, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
+	}
+	e := sf.head
+	for ; index > 0; index-- {
+		e = e.next
+	}
+	return e.value, nil
+}
+
+// RemoveIf removes all of the elements of this queue that satisfy the given predicate.
+// It returns the number of the removed elements.
+func (sf *QueueOf[T]) RemoveIf(pred func(T) bool) int {
+	removed := 0
+	var pre *element[T]
+	for e := sf.head; e != nil; {
+		next := e.next
+		if pred(e.value) {
+			if pre == nil {
+				sf.head = next
+			} else {
+				pre.next = next
+			}
+			e.next = nil
+			removed++
+		} else {
+			pre = e
+		}
+		e = next
+	}
+	sf.tail = pre
+	sf.length -= removed
+	return removed
+}
+
+// Iterator returns an iterator over the elements in this queue from head to tail.
+func (sf *QueueOf[T]) Iterator(f func(T) bool) {
+	for e := sf.head; e != nil; e = e.next {
+		if f == nil || !f(e.value) {
+			return
+		}
+	}
+}
+
+// ReverseIterator returns an iterator over the elements in this queue from tail to head as Iterator.
+// It takes O(n) extra memory, as the queue is singly linked.
+func (sf *QueueOf[T]) ReverseIterator(f func(T) bool) {
+	values := sf.Values()
+	for i := len(values) - 1; i >= 0; i-- {
+		if f == nil || !f(values[i]) {
+			return
+		}
+	}
+}
+
+// Values returns a copy of all the elements from head to tail.
+func (sf *QueueOf[T]) Values() []T {
+	values := make([]T, 0, sf.length)
+	for e := sf.head; e != nil; e = e.next {
+		values = append(values, e.value)
+	}
+	return values
+}
+
+// All returns an iterator over the elements in this queue from head to tail.
+func (sf *QueueOf[T]) All() iter.Seq[T] { return sf.Iterator }
+
+// Backward returns an iterator over the elements in this queue from tail to head.
+// It takes O(n) extra memory, as the queue is singly linked.
+func (sf *QueueOf[T]) Backward() iter.Seq[T] { return sf.ReverseIterator }
+
+func (sf *QueueOf[T]) compare(v1, v2 T) bool {
 	if sf.cmp != nil {
-		return sf.cmp.Compare(v1, v2) == 0
+		return sf.cmp(v1, v2) == 0
 	}
-	return v1 == v2
+	return any(v1) == any(v2)
 }
@@ -19,3 +19,11 @@ func BenchmarkQuickQueue(b *testing.B) {
 		q.Poll()
 	}
 }
+
+func BenchmarkRing(b *testing.B) {
+	q := NewRing(1024)
+	for i := 0; i < b.N; i++ {
+		q.Add(1)
+		q.Poll()
+	}
+}
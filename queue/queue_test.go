@@ -15,10 +15,13 @@
 package queue
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
 )
 
 func TestQueueLen(t *testing.T) {
@@ -192,3 +195,95 @@ func (s *student) Compare(v1, v2 interface{}) int {
 	}
 	return 0
 }
+
+func TestQueueOf(t *testing.T) {
+	var q container.QueueOf[int] = NewOf[int](WithCompareFunc(func(v1, v2 int) int { return v1%10 - v2%10 }))
+	q.Add(15)
+	q.Add(19)
+	q.Add(12)
+
+	require.Equal(t, 3, q.Len())
+	require.Equal(t, 15, q.Peek())
+	require.True(t, q.Contains(22)) // 22 % 10 == 12 % 10
+	require.False(t, q.Contains(13))
+	q.Remove(9)
+	require.False(t, q.Contains(19))
+
+	require.Equal(t, 15, q.Poll())
+	require.Equal(t, 12, q.Poll())
+	require.Zero(t, q.Poll())
+	require.Zero(t, q.Peek())
+	require.True(t, q.IsEmpty())
+}
+
+func TestQueueAll(t *testing.T) {
+	q := NewOf[int]()
+	for i := 1; i <= 5; i++ {
+		q.Add(i)
+	}
+	q.Poll()
+	assert.Equal(t, []int{2, 3, 4, 5}, slices.Collect(q.All()))
+	assert.Equal(t, []int{5, 4, 3, 2}, slices.Collect(q.Backward()))
+	assert.Equal(t, 4, q.Len())
+
+	var got []int
+	for v := range q.All() {
+		if v > 3 {
+			break
+		}
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{2, 3}, got)
+	assert.Empty(t, slices.Collect(NewOf[int]().Backward()))
+}
+
+func TestQueueExtended(t *testing.T) {
+	var q container.ExtendedQueueOf[int] = NewOf[int]()
+	assert.Empty(t, q.Values())
+	_, err := q.Get(0)
+	assert.Error(t, err)
+
+	q.AddAll(1, 2, 3, 4, 5, 6)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, q.Values())
+	for i := 0; i < q.Len(); i++ {
+		v, err := q.Get(i)
+		require.NoError(t, err)
+		assert.Equal(t, i+1, v)
+	}
+	_, err = q.Get(6)
+	assert.Error(t, err)
+	_, err = q.Get(-1)
+	assert.Error(t, err)
+
+	var got []int
+	q.ReverseIterator(func(v int) bool {
+		got = append(got, v)
+		return v > 4
+	})
+	assert.Equal(t, []int{6, 5, 4}, got)
+	got = got[:0]
+	q.Iterator(func(v int) bool {
+		got = append(got, v)
+		return v < 2
+	})
+	assert.Equal(t, []int{1, 2}, got)
+	q.Iterator(nil)
+	q.ReverseIterator(nil)
+
+	assert.Equal(t, 3, q.RemoveIf(func(v int) bool { return v%2 == 0 }))
+	assert.Equal(t, []int{1, 3, 5}, q.Values())
+	assert.Equal(t, 3, q.Len())
+	q.Add(7)
+	assert.Equal(t, []int{1, 3, 5, 7}, q.Values())
+
+	assert.Equal(t, 2, q.RemoveIf(func(v int) bool { return v == 1 || v == 7 }))
+	q.Add(9)
+	assert.Equal(t, []int{3, 5, 9}, q.Values())
+	assert.Equal(t, 3, q.Poll())
+
+	assert.Equal(t, 2, q.RemoveIf(func(int) bool { return true }))
+	assert.True(t, q.IsEmpty())
+	q.Add(1)
+	assert.Equal(t, 1, q.Peek())
+	assert.Zero(t, q.RemoveIf(func(int) bool { return false }))
+}
@@ -1,71 +1,84 @@
 package queue
 
 import (
+	"fmt"
+	"iter"
+
 	"github.com/thinkgos/container"
 	"github.com/thinkgos/container/comparator"
 )
 
-var _ container.Queue = (*QuickQueue)(nil)
+var _ container.ExtendedQueue = (*QuickQueue)(nil)
+
+// QuickQueue implement with slice of interface{} elements.
+type QuickQueue = QuickQueueOf[interface{}]
 
-// QuickQueue implement with slice.
-type QuickQueue struct {
+// QuickQueueOf implement with slice of T elements.
+type QuickQueueOf[T any] struct {
 	headPos int
-	head    []interface{}
-	tail    []interface{}
-	cmp     comparator.Comparator
+	head    []T
+	tail    []T
+	cmp     comparator.CompareFunc[T]
 }
 
 // NewQuickQueue new quick queue.
 func NewQuickQueue(opts ...Option) *QuickQueue {
-	q := new(QuickQueue)
+	return NewQuickQueueOf[interface{}](opts...)
+}
+
+// NewQuickQueueOf new quick queue of T elements.
+func NewQuickQueueOf[T any](opts ...Option) *QuickQueueOf[T] {
+	q := new(QuickQueueOf[T])
 	for _, opt := range opts {
 		opt(q)
 	}
 	return q
 }
 
-func (sf *QuickQueue) apply(c comparator.Comparator) { sf.cmp = c }
+func (sf *QuickQueueOf[T]) apply(c comparator.Comparator) { sf.cmp = comparator.FuncOf[T](c) }
 
 // Len returns the length of this queue.
-func (sf *QuickQueue) Len() int { return len(sf.head) - sf.headPos + len(sf.tail) }
+func (sf *QuickQueueOf[T]) Len() int { return len(sf.head) - sf.headPos + len(sf.tail) }
 
 // IsEmpty returns true if this Queue contains no elements.
-func (sf *QuickQueue) IsEmpty() bool { return sf.Len() == 0 }
+func (sf *QuickQueueOf[T]) IsEmpty() bool { return sf.Len() == 0 }
 
 // Clear initializes or clears queue.
-func (sf *QuickQueue) Clear() { sf.head, sf.tail, sf.headPos = nil, nil, 0 } // should set nil for gc
+func (sf *QuickQueueOf[T]) Clear() { sf.head, sf.tail, sf.headPos = nil, nil, 0 } // should set nil for gc
 
 // Add items to the queue.
-func (sf *QuickQueue) Add(v interface{}) { sf.tail = append(sf.tail, v) }
+func (sf *QuickQueueOf[T]) Add(v T) { sf.tail = append(sf.tail, v) }
 
-// Peek retrieves, but does not remove, the head of this Queue, or return nil if this Queue is empty.
-func (sf *QuickQueue) Peek() interface{} {
+// Peek retrieves, but does not remove, the head of this Queue, or return the zero value if this Queue is empty.
+func (sf *QuickQueueOf[T]) Peek() (val T) {
 	if sf.headPos < len(sf.head) {
 		return sf.head[sf.headPos]
 	}
 	if len(sf.tail) > 0 {
 		return sf.tail[0]
 	}
-	return nil
+	return val
 }
 
-// Poll retrieves and removes the head of the this Queue, or return nil if this Queue is empty.
-func (sf *QuickQueue) Poll() interface{} {
+// Poll retrieves and removes the head of the this Queue, or return the zero value if this Queue is empty.
+func (sf *QuickQueueOf[T]) Poll() (val T) {
+	var zero T
+
 	if sf.headPos >= len(sf.head) {
 		if len(sf.tail) == 0 {
-			return nil
+			return zero
 		}
 		// Pick up tail as new head, clear tail.
 		sf.head, sf.headPos, sf.tail = sf.tail, 0, sf.head[:0]
 	}
-	v := sf.head[sf.headPos]
-	sf.head[sf.headPos] = nil // should set nil for gc
+	val = sf.head[sf.headPos]
+	sf.head[sf.headPos] = zero // should set zero for gc
 	sf.headPos++
-	return v
+	return val
 }
 
 // Contains returns true if this queue contains the specified element.
-func (sf *QuickQueue) Contains(val interface{}) bool {
+func (sf *QuickQueueOf[T]) Contains(val T) bool {
 	for i := sf.headPos; i < len(sf.head); i++ {
 		if sf.compare(sf.head[i], val) {
 			return true
@@ -80,7 +93,8 @@ func (sf *QuickQueue) Contains(val interface{}) bool {
 }
 
 // Remove a single instance of the specified element from this queue, if it is present.
-func (sf *QuickQueue) Remove(val interface{}) {
+func (sf *QuickQueueOf[T]) Remove(val T) {
+	var zero T
 	var found bool
 	var idx int
 
@@ -93,11 +107,11 @@ func (sf *QuickQueue) Remove(val interface{}) {
 	if found {
 		if (idx - sf.headPos) < (len(sf.head)-sf.headPos)/2 {
 			moveLastToFirst(sf.head[sf.headPos:(idx + 1)])
-			sf.head[sf.headPos] = nil // should set nil for gc
+			sf.head[sf.headPos] = zero // should set zero for gc
 			sf.headPos++
 		} else {
 			moveFirstToLast(sf.head[idx:])
-			sf.head[len(sf.head)-1] = nil // should set nil for gc
+			sf.head[len(sf.head)-1] = zero // should set zero for gc
 			sf.head = sf.head[:len(sf.head)-1]
 		}
 		return
@@ -111,25 +125,110 @@ func (sf *QuickQueue) Remove(val interface{}) {
 	}
 	if found {
 		moveFirstToLast(sf.tail[idx:])
-		sf.tail[len(sf.tail)-1] = nil // should set nil for gc
+		sf.tail[len(sf.tail)-1] = zero // should set zero for gc
 		sf.tail = sf.tail[:len(sf.tail)-1]
 	}
 }
 
-func (sf *QuickQueue) compare(v1, v2 interface{}) bool {
+// AddAll inserts the elements into the tail of this queue in order.
+func (sf *QuickQueueOf[T]) AddAll(vals ...T) { sf.tail = append(sf.tail, vals...) }
+
+// Get returns the element at the specified position from the head. The index must be in the range of [0, size).
+func (sf *QuickQueueOf[T]) Get(index int) (T, error) {
+	if index < 0 || index >= sf.Len() {
+		var zero T
+		return zero, fmt.Errorf("index out of range, index:%d, len:%d", index, sf.Len())
+	}
+	if n := len(sf.head) - sf.headPos; index >= n {
+		return sf.tail[index-n], nil
+	}
+	return sf.head[sf.headPos+index], nil
+}
+
+// RemoveIf removes all of the elements of this queue that satisfy the given predicate.
+// It returns the number of the removed elements.
+func (sf *QuickQueueOf[T]) RemoveIf(pred func(T) bool) int {
+	var removed int
+
+	sf.head, removed = removeIf(sf.head[sf.headPos:], pred)
+	sf.headPos = 0
+	tail, n := removeIf(sf.tail, pred)
+	sf.tail = tail
+	return removed + n
+}
+
+// removeIf removes the elements that satisfy pred in place, the vacated slots are set zero.
+func removeIf[T any](values []T, pred func(T) bool) ([]T, int) {
+	var zero T
+
+	n := 0
+	for _, v := range values {
+		if !pred(v) {
+			values[n] = v
+			n++
+		}
+	}
+	for i := n; i < len(values); i++ {
+		values[i] = zero // should set zero for gc
+	}
+	return values[:n], len(values) - n
+}
+
+// Iterator returns an iterator over the elements in this queue from head to tail.
+func (sf *QuickQueueOf[T]) Iterator(f func(T) bool) {
+	for i := sf.headPos; i < len(sf.head); i++ {
+		if f == nil || !f(sf.head[i]) {
+			return
+		}
+	}
+	for _, v := range sf.tail {
+		if f == nil || !f(v) {
+			return
+		}
+	}
+}
+
+// ReverseIterator returns an iterator over the elements in this queue from tail to head as Iterator.
+func (sf *QuickQueueOf[T]) ReverseIterator(f func(T) bool) {
+	for i := len(sf.tail) - 1; i >= 0; i-- {
+		if f == nil || !f(sf.tail[i]) {
+			return
+		}
+	}
+	for i := len(sf.head) - 1; i >= sf.headPos; i-- {
+		if f == nil || !f(sf.head[i]) {
+			return
+		}
+	}
+}
+
+// Values returns a copy of all the elements from head to tail.
+func (sf *QuickQueueOf[T]) Values() []T {
+	values := make([]T, 0, sf.Len())
+	values = append(values, sf.head[sf.headPos:]...)
+	return append(values, sf.tail...)
+}
+
+// All returns an iterator over the elements in this queue from head to tail.
+func (sf *QuickQueueOf[T]) All() iter.Seq[T] { return sf.Iterator }
+
+// Backward returns an iterator over the elements in this queue from tail to head.
+func (sf *QuickQueueOf[T]) Backward() iter.Seq[T] { return sf.ReverseIterator }
+
+func (sf *QuickQueueOf[T]) compare(v1, v2 T) bool {
 	if sf.cmp != nil {
-		return sf.cmp.Compare(v1, v2) == 0
+		return sf.cmp(v1, v2) == 0
 	}
-	return v1 == v2
+	return any(v1) == any(v2)
 }
 
-func moveLastToFirst(items []interface{}) {
+func moveLastToFirst[T any](items []T) {
 	for i := 0; i < len(items); i++ {
 		items[i], items[len(items)-1] = items[len(items)-1], items[i]
 	}
 }
 
-func moveFirstToLast(items []interface{}) {
+func moveFirstToLast[T any](items []T) {
 	for i := 0; i < len(items); i++ {
 		items[0], items[len(items)-1-i] = items[len(items)-1-i], items[0]
 	}
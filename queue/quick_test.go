@@ -15,10 +15,14 @@
 package queue
 
 import (
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
 )
 
 func TestQuickQueueLen(t *testing.T) {
@@ -185,3 +189,100 @@ func TestQuickQueueWithComparator(t *testing.T) {
 	require.Nil(t, q.Peek())
 	require.Nil(t, q.Poll())
 }
+
+func TestQuickQueueOf(t *testing.T) {
+	var q container.QueueOf[string] = NewQuickQueueOf[string](WithCompareFunc(strings.Compare))
+	q.Add("benjamin")
+	q.Add("alice")
+	q.Add("john")
+
+	require.Equal(t, 3, q.Len())
+	require.Equal(t, "benjamin", q.Peek())
+	require.True(t, q.Contains("alice"))
+	q.Remove("alice")
+	require.False(t, q.Contains("alice"))
+
+	require.Equal(t, "benjamin", q.Poll())
+	require.Equal(t, "john", q.Poll())
+	require.Zero(t, q.Poll())
+	require.Zero(t, q.Peek())
+	require.True(t, q.IsEmpty())
+
+	// mismatched comparator panics
+	require.Panics(t, func() {
+		q := NewQuickQueueOf[int](WithComparator(&student{}))
+		q.Add(1)
+		q.Contains(1)
+	})
+}
+
+func TestQuickQueueAll(t *testing.T) {
+	q := NewQuickQueueOf[int]()
+	for i := 1; i <= 3; i++ {
+		q.Add(i)
+	}
+	q.Poll() // move the elements to head
+	q.Add(4)
+	q.Add(5)
+	assert.Equal(t, []int{2, 3, 4, 5}, slices.Collect(q.All()))
+	assert.Equal(t, []int{5, 4, 3, 2}, slices.Collect(q.Backward()))
+	assert.Equal(t, 4, q.Len())
+
+	var got []int
+	for v := range q.Backward() {
+		if v < 4 {
+			break
+		}
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{5, 4}, got)
+	assert.Empty(t, slices.Collect(NewQuickQueueOf[int]().All()))
+}
+
+func TestQuickQueueExtended(t *testing.T) {
+	var q container.ExtendedQueueOf[int] = NewQuickQueueOf[int]()
+	assert.Empty(t, q.Values())
+	_, err := q.Get(0)
+	assert.Error(t, err)
+
+	q.AddAll(0, 1, 2, 3)
+	q.Poll() // move the elements to head
+	q.AddAll(4, 5, 6)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, q.Values())
+	for i := 0; i < q.Len(); i++ {
+		v, err := q.Get(i)
+		require.NoError(t, err)
+		assert.Equal(t, i+1, v)
+	}
+	_, err = q.Get(6)
+	assert.Error(t, err)
+	_, err = q.Get(-1)
+	assert.Error(t, err)
+
+	var got []int
+	q.ReverseIterator(func(v int) bool {
+		got = append(got, v)
+		return v > 2
+	})
+	assert.Equal(t, []int{6, 5, 4, 3, 2}, got)
+	got = got[:0]
+	q.Iterator(func(v int) bool {
+		got = append(got, v)
+		return v < 5
+	})
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, got)
+	q.Iterator(nil)
+	q.ReverseIterator(nil)
+
+	assert.Equal(t, 3, q.RemoveIf(func(v int) bool { return v%2 == 0 }))
+	assert.Equal(t, []int{1, 3, 5}, q.Values())
+	assert.Equal(t, 3, q.Len())
+	q.Add(7)
+	assert.Equal(t, 1, q.Poll())
+	assert.Equal(t, []int{3, 5, 7}, q.Values())
+
+	assert.Equal(t, 3, q.RemoveIf(func(int) bool { return true }))
+	assert.True(t, q.IsEmpty())
+	q.Add(1)
+	assert.Equal(t, 1, q.Poll())
+}
@@ -0,0 +1,247 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+
+	"github.com/thinkgos/container"
+	"github.com/thinkgos/container/comparator"
+)
+
+var _ container.Queue = (*Ring)(nil)
+
+// ErrFull is returned by Offer when the Ring is full and the element is rejected.
+var ErrFull = errors.New("queue: ring is full")
+
+// FullPolicy is the behaviour of the Ring when an element is added but the Ring is full.
+type FullPolicy int
+
+// The full policies.
+const (
+	// Overwrite overwrites the oldest element, which is the head of the Ring.
+	Overwrite FullPolicy = iota
+	// Reject rejects the new element, Offer returns ErrFull.
+	Reject
+	// Callback calls the callback set by WithOnFull to decide to overwrite or to reject.
+	Callback
+)
+
+// ringApply is the Apply of the Ring options.
+type ringApply interface {
+	applyPolicy(p FullPolicy)
+}
+
+// WithFullPolicy with the full policy of the Ring, default Overwrite.
+// It is ignored by the other queues.
+func WithFullPolicy(p FullPolicy) Option {
+	return func(a Apply) {
+		if r, ok := a.(ringApply); ok {
+			r.applyPolicy(p)
+		}
+	}
+}
+
+// WithOnFull with the callback of the RingOf[T] when it is full, the policy is set to Callback.
+// The callback is called with the oldest element and the new element,
+// it returns true to overwrite the oldest, false to reject the new one.
+// It is ignored by the other queues, but it panics on a Ring of other elements.
+func WithOnFull[T any](f func(oldest, v T) bool) Option {
+	return func(a Apply) {
+		switch r := a.(type) {
+		case *RingOf[T]:
+			r.policy, r.onFull = Callback, f
+		case ringApply:
+			panic(fmt.Sprintf("queue: WithOnFull of %T can't be used with %T", f, r))
+		}
+	}
+}
+
+// Ring is a fixed-capacity queue of interface{} elements.
+type Ring = RingOf[interface{}]
+
+// RingOf is a fixed-capacity queue of T elements based on a ring buffer,
+// it does not allocate after it is created.
+type RingOf[T any] struct {
+	buf    []T
+	head   int
+	size   int
+	policy FullPolicy
+	onFull func(oldest, v T) bool
+	cmp    comparator.CompareFunc[T]
+}
+
+// NewRing creates a Ring with the capacity. It panics if capacity <= 0.
+func NewRing(capacity int, opts ...Option) *Ring {
+	return NewRingOf[interface{}](capacity, opts...)
+}
+
+// NewRingOf creates a RingOf of T elements with the capacity. It panics if capacity <= 0.
+func NewRingOf[T any](capacity int, opts ...Option) *RingOf[T] {
+	if capacity <= 0 {
+		panic("queue: ring capacity must be positive")
+	}
+	q := &RingOf[T]{buf: make([]T, capacity)}
+	for _, opt := range opts {
+		opt(q)
+	}
+	return q
+}
+
+func (sf *RingOf[T]) apply(c comparator.Comparator) { sf.cmp = comparator.FuncOf[T](c) }
+
+func (sf *RingOf[T]) applyPolicy(p FullPolicy) { sf.policy = p }
+
+// Cap returns the capacity of this Ring.
+func (sf *RingOf[T]) Cap() int { return len(sf.buf) }
+
+// Len returns the length of this Ring.
+func (sf *RingOf[T]) Len() int { return sf.size }
+
+// IsEmpty returns true if this Ring contains no elements.
+func (sf *RingOf[T]) IsEmpty() bool { return sf.size == 0 }
+
+// IsFull returns true if this Ring contains Cap elements.
+func (sf *RingOf[T]) IsFull() bool { return sf.size == len(sf.buf) }
+
+// Clear removes all the elements from this Ring, the buffer is kept.
+func (sf *RingOf[T]) Clear() {
+	clear(sf.buf) // should set zero for gc
+	sf.head, sf.size = 0, 0
+}
+
+// Add inserts an element into the tail of this Ring, the element may be rejected by the full policy.
+func (sf *RingOf[T]) Add(v T) { _ = sf.Offer(v) }
+
+// Offer inserts an element into the tail of this Ring.
+// If the Ring is full, the oldest element is overwritten or the element is rejected by the full policy,
+// it returns ErrFull if the element is rejected.
+func (sf *RingOf[T]) Offer(v T) error {
+	if sf.size == len(sf.buf) {
+		overwrite := false
+		switch sf.policy {
+		case Overwrite:
+			overwrite = true
+		case Callback:
+			overwrite = sf.onFull != nil && sf.onFull(sf.buf[sf.head], v)
+		}
+		if !overwrite {
+			return ErrFull
+		}
+		sf.buf[sf.head] = v
+		sf.head = sf.index(1)
+		return nil
+	}
+	sf.buf[sf.index(sf.size)] = v
+	sf.size++
+	return nil
+}
+
+// Peek retrieves, but does not remove, the head of this Ring, or return the zero value if this Ring is empty.
+func (sf *RingOf[T]) Peek() (val T) {
+	if sf.size > 0 {
+		val = sf.buf[sf.head]
+	}
+	return val
+}
+
+// Poll retrieves and removes the head of the this Ring, or return the zero value if this Ring is empty.
+func (sf *RingOf[T]) Poll() (val T) {
+	if sf.size == 0 {
+		return val
+	}
+	var zero T
+
+	val = sf.buf[sf.head]
+	sf.buf[sf.head] = zero // should set zero for gc
+	sf.head = sf.index(1)
+	sf.size--
+	return val
+}
+
+// Contains returns true if this Ring contains the specified element.
+func (sf *RingOf[T]) Contains(val T) bool { return sf.indexOf(val) >= 0 }
+
+// Remove a single instance of the specified element from this Ring, if it is present.
+func (sf *RingOf[T]) Remove(val T) {
+	idx := sf.indexOf(val)
+	if idx < 0 {
+		return
+	}
+	var zero T
+
+	for i := idx; i < sf.size-1; i++ {
+		sf.buf[sf.index(i)] = sf.buf[sf.index(i+1)]
+	}
+	sf.buf[sf.index(sf.size-1)] = zero // should set zero for gc
+	sf.size--
+}
+
+// Values returns a snapshot of the elements from head to tail.
+func (sf *RingOf[T]) Values() []T {
+	values := make([]T, sf.size)
+	n := copy(values, sf.buf[sf.head:min(sf.head+sf.size, len(sf.buf))])
+	copy(values[n:], sf.buf[:sf.size-n])
+	return values
+}
+
+// All returns an iterator over the elements in this Ring from head to tail.
+func (sf *RingOf[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < sf.size; i++ {
+			if !yield(sf.buf[sf.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements in this Ring from tail to head.
+func (sf *RingOf[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := sf.size - 1; i >= 0; i-- {
+			if !yield(sf.buf[sf.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// index returns the position in the buffer of the i-th element from the head.
+func (sf *RingOf[T]) index(i int) int {
+	i += sf.head
+	if i >= len(sf.buf) {
+		i -= len(sf.buf)
+	}
+	return i
+}
+
+func (sf *RingOf[T]) indexOf(val T) int {
+	for i := 0; i < sf.size; i++ {
+		if sf.compare(sf.buf[sf.index(i)], val) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (sf *RingOf[T]) compare(v1, v2 T) bool {
+	if sf.cmp != nil {
+		return sf.cmp(v1, v2) == 0
+	}
+	return any(v1) == any(v2)
+}
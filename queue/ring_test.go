@@ -0,0 +1,132 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+)
+
+func TestRing(t *testing.T) {
+	assert.Panics(t, func() { NewRing(0) })
+
+	q := NewRing(3)
+	assert.Equal(t, 3, q.Cap())
+	assert.True(t, q.IsEmpty())
+	assert.Nil(t, q.Peek())
+	assert.Nil(t, q.Poll())
+
+	q.Add(1)
+	q.Add(2)
+	q.Add(3)
+	assert.True(t, q.IsFull())
+	assert.Equal(t, []interface{}{1, 2, 3}, q.Values())
+
+	// overwrite the oldest by default
+	require.NoError(t, q.Offer(4))
+	q.Add(5)
+	assert.Equal(t, 3, q.Len())
+	assert.Equal(t, []interface{}{3, 4, 5}, q.Values())
+	assert.Equal(t, []interface{}{5, 4, 3}, slices.Collect(q.Backward()))
+	assert.Equal(t, 3, q.Peek())
+	assert.True(t, q.Contains(4))
+	assert.False(t, q.Contains(1))
+
+	q.Remove(4)
+	assert.Equal(t, []interface{}{3, 5}, q.Values())
+	q.Add(6)
+	q.Add(7)
+	assert.Equal(t, []interface{}{5, 6, 7}, slices.Collect(q.All()))
+	assert.Equal(t, 5, q.Poll())
+	assert.Equal(t, 6, q.Poll())
+	assert.Equal(t, 7, q.Poll())
+	assert.True(t, q.IsEmpty())
+
+	q.Add(8)
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+	assert.Empty(t, q.Values())
+	assert.Equal(t, 3, q.Cap())
+}
+
+func TestRingReject(t *testing.T) {
+	var q container.QueueOf[int] = NewRingOf[int](2, WithFullPolicy(Reject))
+	q.Add(1)
+	q.Add(2)
+	q.Add(3)
+	assert.Equal(t, []int{1, 2}, q.(*RingOf[int]).Values())
+	assert.ErrorIs(t, q.(*RingOf[int]).Offer(3), ErrFull)
+
+	q.Poll()
+	require.NoError(t, q.(*RingOf[int]).Offer(3))
+	assert.Equal(t, []int{2, 3}, q.(*RingOf[int]).Values())
+}
+
+func TestRingOnFull(t *testing.T) {
+	var dropped []int
+	q := NewRingOf[int](2, WithOnFull(func(oldest, v int) bool {
+		if v%2 == 0 { // keep the even ones only
+			dropped = append(dropped, oldest)
+			return true
+		}
+		return false
+	}))
+	for i := 1; i <= 6; i++ {
+		_ = q.Offer(i)
+	}
+	assert.Equal(t, []int{4, 6}, q.Values())
+	assert.Equal(t, []int{1, 2}, dropped)
+	assert.ErrorIs(t, q.Offer(7), ErrFull)
+
+	// without callback, it rejects
+	q = NewRingOf[int](1, WithFullPolicy(Callback))
+	q.Add(1)
+	assert.ErrorIs(t, q.Offer(2), ErrFull)
+
+	// ignored by the other queues, but panics on the other element type
+	assert.NotPanics(t, func() {
+		NewQuickQueue(WithFullPolicy(Reject), WithOnFull(func(oldest, v int) bool { return true }))
+	})
+	assert.Panics(t, func() {
+		NewRingOf[int](1, WithOnFull(func(oldest, v string) bool { return false }))
+	})
+}
+
+func TestRingComparator(t *testing.T) {
+	q := NewRingOf[string](3, WithCompareFunc(func(a, b string) int { return int(a[0]) - int(b[0]) }))
+	q.Add("apple")
+	q.Add("banana")
+	assert.True(t, q.Contains("box"))
+	q.Remove("bar")
+	assert.Equal(t, []string{"apple"}, q.Values())
+}
+
+func TestRingNoAlloc(t *testing.T) {
+	q := NewRingOf[int](64)
+	allocs := testing.AllocsPerRun(100, func() {
+		for i := 0; i < 100; i++ {
+			q.Add(i)
+		}
+		for i := 0; i < 50; i++ {
+			q.Poll()
+		}
+	})
+	assert.Zero(t, allocs)
+}
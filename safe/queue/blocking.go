@@ -0,0 +1,296 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package queue implements the thread-safe queues.
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thinkgos/container"
+	"github.com/thinkgos/container/comparator"
+	"github.com/thinkgos/container/queue"
+)
+
+var _ container.Queue = (*BlockingQueue)(nil)
+
+// ErrClosed is returned when put into a closed queue, or take from a closed and empty queue.
+var ErrClosed = errors.New("queue: queue is closed")
+
+type options struct {
+	capacity int
+	cmp      comparator.Comparator
+}
+
+// Option option for New.
+type Option func(o *options)
+
+// WithCapacity with the capacity, the puts block and the adds drop when the queue is full, default 0 means unbounded.
+func WithCapacity(capacity int) Option {
+	return func(o *options) {
+		o.capacity = capacity
+	}
+}
+
+// WithComparator with user's Comparator.
+func WithComparator(cmp comparator.Comparator) Option {
+	return func(o *options) {
+		o.cmp = cmp
+	}
+}
+
+// WithCompareFunc with user's CompareFunc of T elements.
+func WithCompareFunc[T any](f comparator.CompareFunc[T]) Option {
+	return WithComparator(f)
+}
+
+// BlockingQueue is a thread-safe FIFO queue of interface{} elements,
+// which blocks the takes when it is empty and the puts when it is full.
+type BlockingQueue = BlockingQueueOf[interface{}]
+
+// BlockingQueueOf is a thread-safe FIFO queue of T elements based on queue.QuickQueueOf,
+// which blocks the takes when it is empty and the puts when it is full, like Java's LinkedBlockingQueue.
+type BlockingQueueOf[T any] struct {
+	mu       sync.Mutex
+	items    *queue.QuickQueueOf[T]
+	capacity int
+	closed   bool
+	// notEmpty and notFull are closed to wake up the waiters, they are created only when someone is waiting.
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+// New creates a BlockingQueue.
+func New(opts ...Option) *BlockingQueue {
+	return NewOf[interface{}](opts...)
+}
+
+// NewOf creates a BlockingQueueOf of T elements.
+func NewOf[T any](opts ...Option) *BlockingQueueOf[T] {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &BlockingQueueOf[T]{
+		items:    queue.NewQuickQueueOf[T](queue.WithComparator(o.cmp)),
+		capacity: o.capacity,
+	}
+}
+
+// Cap returns the capacity of this queue, 0 means unbounded.
+func (sf *BlockingQueueOf[T]) Cap() int { return sf.capacity }
+
+// Len returns the length of this queue.
+func (sf *BlockingQueueOf[T]) Len() int {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	return sf.items.Len()
+}
+
+// IsEmpty returns true if this queue contains no elements.
+func (sf *BlockingQueueOf[T]) IsEmpty() bool { return sf.Len() == 0 }
+
+// Clear removes all the elements from this queue.
+func (sf *BlockingQueueOf[T]) Clear() {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	sf.items.Clear()
+	sf.signalNotFull()
+}
+
+// Add inserts an element into the tail of this queue if there is space, it never blocks,
+// so a full queue doesn't deadlock the callers of container.Queue. Same as Offer with no waiting,
+// the element is dropped if this queue is full or closed, use Offer to know whether it is inserted,
+// or Put to wait for space.
+func (sf *BlockingQueueOf[T]) Add(v T) { sf.Offer(v, 0) }
+
+// Put inserts an element into the tail of this queue, waiting for space if necessary.
+// It returns ErrClosed if this queue is closed, or ctx.Err() if ctx is done before space is available.
+func (sf *BlockingQueueOf[T]) Put(ctx context.Context, v T) error {
+	sf.mu.Lock()
+	for {
+		if sf.closed {
+			sf.mu.Unlock()
+			return ErrClosed
+		}
+		if sf.capacity <= 0 || sf.items.Len() < sf.capacity {
+			sf.items.Add(v)
+			sf.signalNotEmpty()
+			sf.mu.Unlock()
+			return nil
+		}
+		if sf.notFull == nil {
+			sf.notFull = make(chan struct{})
+		}
+		ch := sf.notFull
+		sf.mu.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		sf.mu.Lock()
+	}
+}
+
+// Offer inserts an element into the tail of this queue, waiting up to the timeout for space if necessary.
+// It returns false if this queue is closed or the timeout elapses, timeout <= 0 means no waiting.
+func (sf *BlockingQueueOf[T]) Offer(v T, timeout time.Duration) bool {
+	ctx, cancel := timeoutContext(timeout)
+	defer cancel()
+	return sf.Put(ctx, v) == nil
+}
+
+// Take retrieves and removes the head of this queue, waiting until an element is available.
+// It returns ErrClosed if this queue is closed and empty, or ctx.Err() if ctx is done before an element is available.
+// The elements remaining in a closed queue can still be taken.
+func (sf *BlockingQueueOf[T]) Take(ctx context.Context) (T, error) {
+	sf.mu.Lock()
+	for {
+		if sf.items.Len() > 0 {
+			v := sf.items.Poll()
+			sf.signalNotFull()
+			sf.mu.Unlock()
+			return v, nil
+		}
+		if sf.closed {
+			sf.mu.Unlock()
+			var zero T
+			return zero, ErrClosed
+		}
+		if sf.notEmpty == nil {
+			sf.notEmpty = make(chan struct{})
+		}
+		ch := sf.notEmpty
+		sf.mu.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+		sf.mu.Lock()
+	}
+}
+
+// PollTimeout retrieves and removes the head of this queue, waiting up to the timeout for an element if necessary.
+// It returns false if this queue is closed and empty or the timeout elapses, timeout <= 0 means no waiting.
+func (sf *BlockingQueueOf[T]) PollTimeout(timeout time.Duration) (T, bool) {
+	ctx, cancel := timeoutContext(timeout)
+	defer cancel()
+	v, err := sf.Take(ctx)
+	return v, err == nil
+}
+
+// Peek retrieves, but does not remove, the head of this queue, or return the zero value if this queue is empty.
+func (sf *BlockingQueueOf[T]) Peek() T {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	return sf.items.Peek()
+}
+
+// Poll retrieves and removes the head of this queue without waiting, or return the zero value if this queue is empty.
+func (sf *BlockingQueueOf[T]) Poll() T {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	if sf.items.Len() == 0 {
+		var zero T
+		return zero
+	}
+	v := sf.items.Poll()
+	sf.signalNotFull()
+	return v
+}
+
+// Contains returns true if this queue contains the specified element.
+func (sf *BlockingQueueOf[T]) Contains(val T) bool {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	return sf.items.Contains(val)
+}
+
+// Remove a single instance of the specified element from this queue, if it is present.
+func (sf *BlockingQueueOf[T]) Remove(val T) {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	n := sf.items.Len()
+	sf.items.Remove(val)
+	if sf.items.Len() < n {
+		sf.signalNotFull()
+	}
+}
+
+// DrainTo removes at most n available elements without waiting, and appends them to dst in order,
+// n <= 0 means all. It returns the extended dst.
+func (sf *BlockingQueueOf[T]) DrainTo(dst []T, n int) []T {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	if n <= 0 || n > sf.items.Len() {
+		n = sf.items.Len()
+	}
+	for i := 0; i < n; i++ {
+		dst = append(dst, sf.items.Poll())
+	}
+	if n > 0 {
+		sf.signalNotFull()
+	}
+	return dst
+}
+
+// Close closes this queue, wakes up all the waiters, the puts fail with ErrClosed,
+// the takes fail with ErrClosed when the remaining elements are taken. Close is idempotent.
+func (sf *BlockingQueueOf[T]) Close() {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	sf.closed = true
+	sf.signalNotEmpty()
+	sf.signalNotFull()
+}
+
+// IsClosed returns true if this queue is closed.
+func (sf *BlockingQueueOf[T]) IsClosed() bool {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	return sf.closed
+}
+
+// signalNotEmpty wakes up the takers, the lock must be held.
+func (sf *BlockingQueueOf[T]) signalNotEmpty() {
+	if sf.notEmpty != nil {
+		close(sf.notEmpty)
+		sf.notEmpty = nil
+	}
+}
+
+// signalNotFull wakes up the putters, the lock must be held.
+func (sf *BlockingQueueOf[T]) signalNotFull() {
+	if sf.notFull != nil {
+		close(sf.notFull)
+		sf.notFull = nil
+	}
+}
+
+func timeoutContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		return ctx, cancel
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
@@ -0,0 +1,211 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	var q container.Queue = New()
+	assert.True(t, q.IsEmpty())
+	assert.Nil(t, q.Peek())
+	assert.Nil(t, q.Poll())
+
+	q.Add(1)
+	q.Add("hello")
+	q.Add(2)
+	assert.Equal(t, 3, q.Len())
+	assert.True(t, q.Contains("hello"))
+	q.Remove("hello")
+	assert.False(t, q.Contains("hello"))
+	assert.Equal(t, 1, q.Peek())
+	assert.Equal(t, 1, q.Poll())
+	assert.Equal(t, 2, q.Poll())
+	q.Add(3)
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+
+	bq := NewOf[string](WithCompareFunc(func(a, b string) int { return int(a[0]) - int(b[0]) }))
+	bq.Add("apple")
+	assert.True(t, bq.Contains("ant"))
+	assert.Equal(t, 0, bq.Cap())
+}
+
+func TestBlockingQueueAddFull(t *testing.T) {
+	var q container.QueueOf[int] = NewOf[int](WithCapacity(2))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 1; i <= 3; i++ {
+			q.Add(i)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Add blocks on a full queue")
+	}
+	// the element added to a full queue is dropped
+	assert.Equal(t, 2, q.Len())
+	assert.Equal(t, 1, q.Poll())
+	assert.Equal(t, 2, q.Poll())
+	assert.True(t, q.IsEmpty())
+}
+
+func TestBlockingQueuePutTake(t *testing.T) {
+	q := NewOf[int](WithCapacity(2))
+	ctx := context.Background()
+	require.NoError(t, q.Put(ctx, 1))
+	require.NoError(t, q.Put(ctx, 2))
+	assert.Equal(t, 2, q.Cap())
+
+	// full
+	assert.False(t, q.Offer(3, 0))
+	assert.False(t, q.Offer(3, 10*time.Millisecond))
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, q.Put(timeoutCtx, 3), context.DeadlineExceeded)
+
+	// put blocks until take
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, q.Put(ctx, 3))
+	}()
+	time.Sleep(10 * time.Millisecond)
+	v, err := q.Take(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	<-done
+	assert.Equal(t, []int{2, 3}, q.DrainTo(nil, 0))
+
+	// empty
+	_, ok := q.PollTimeout(0)
+	assert.False(t, ok)
+	_, ok = q.PollTimeout(10 * time.Millisecond)
+	assert.False(t, ok)
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = q.Take(canceledCtx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// take blocks until put
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		assert.True(t, q.Offer(4, time.Second))
+	}()
+	v, ok = q.PollTimeout(time.Second)
+	require.True(t, ok)
+	assert.Equal(t, 4, v)
+}
+
+func TestBlockingQueueDrainTo(t *testing.T) {
+	q := NewOf[int](WithCapacity(5))
+	for i := 0; i < 5; i++ {
+		q.Add(i)
+	}
+	dst := q.DrainTo([]int{-1}, 2)
+	assert.Equal(t, []int{-1, 0, 1}, dst)
+	assert.Equal(t, []int{2, 3, 4}, q.DrainTo(nil, 10))
+	assert.Empty(t, q.DrainTo(nil, 0))
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	q := NewOf[int](WithCapacity(1))
+	ctx := context.Background()
+	require.NoError(t, q.Put(ctx, 1))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.ErrorIs(t, q.Put(ctx, 2), ErrClosed) // blocked put is woken up
+	}()
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+	q.Close()
+	wg.Wait()
+	assert.True(t, q.IsClosed())
+
+	assert.ErrorIs(t, q.Put(ctx, 3), ErrClosed)
+	q.Add(3) // dropped
+	// the remaining elements can be taken
+	v, err := q.Take(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	_, err = q.Take(ctx)
+	assert.ErrorIs(t, err, ErrClosed)
+
+	// blocked take is woken up
+	q = NewOf[int]()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := q.Take(ctx)
+		assert.ErrorIs(t, err, ErrClosed)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+	wg.Wait()
+}
+
+func TestBlockingQueueConcurrent(t *testing.T) {
+	const producers, consumers, amount = 4, 4, 1000
+
+	q := NewOf[int](WithCapacity(16))
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < amount; i++ {
+				assert.NoError(t, q.Put(ctx, 1))
+			}
+		}()
+	}
+
+	var mu sync.Mutex
+	var total int
+	var cwg sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		cwg.Add(1)
+		go func() {
+			defer cwg.Done()
+			for {
+				v, err := q.Take(ctx)
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				total += v
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	q.Close()
+	cwg.Wait()
+	assert.Equal(t, producers*amount, total)
+}
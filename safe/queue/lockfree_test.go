@@ -0,0 +1,286 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container/queue"
+)
+
+func TestMPMC(t *testing.T) {
+	q := NewMPMC(3)
+	assert.Equal(t, 4, q.Cap())
+	_, ok := q.Dequeue()
+	assert.False(t, ok)
+
+	for i := 0; i < 4; i++ {
+		assert.True(t, q.Enqueue(i))
+	}
+	assert.False(t, q.Enqueue(4))
+	assert.Equal(t, 4, q.Len())
+
+	for round := 0; round < 3; round++ { // wrap around
+		v, ok := q.Dequeue()
+		require.True(t, ok)
+		assert.Equal(t, round, v)
+		assert.True(t, q.Enqueue(round+4))
+	}
+	for i := 3; i < 7; i++ {
+		v, ok := q.Dequeue()
+		require.True(t, ok)
+		assert.Equal(t, i, v)
+	}
+	_, ok = q.Dequeue()
+	assert.False(t, ok)
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestSPSC(t *testing.T) {
+	q := NewSPSC(0)
+	assert.Equal(t, 2, q.Cap())
+	_, ok := q.Dequeue()
+	assert.False(t, ok)
+
+	assert.True(t, q.Enqueue("a"))
+	assert.True(t, q.Enqueue("b"))
+	assert.False(t, q.Enqueue("c"))
+	assert.Equal(t, 2, q.Len())
+
+	v, ok := q.Dequeue()
+	require.True(t, ok)
+	assert.Equal(t, "a", v)
+	assert.True(t, q.Enqueue("c"))
+	v, _ = q.Dequeue()
+	assert.Equal(t, "b", v)
+	v, _ = q.Dequeue()
+	assert.Equal(t, "c", v)
+	_, ok = q.Dequeue()
+	assert.False(t, ok)
+}
+
+// TestMPMCStress checks every element is dequeued exactly once, run it with -race.
+func TestMPMCStress(t *testing.T) {
+	const producers, consumers, amount = 4, 4, 10000
+
+	q := NewMPMCOf[int](64)
+	seen := make([]int, producers*amount)
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < amount; i++ {
+				for !q.Enqueue(p*amount + i) {
+					runtime.Gosched()
+				}
+			}
+		}(p)
+	}
+
+	var mu sync.Mutex
+	var consumed int
+	var cwg sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		cwg.Add(1)
+		go func() {
+			defer cwg.Done()
+			last := make([]int, producers) // the order of each producer is kept
+			for i := range last {
+				last[i] = -1
+			}
+			for {
+				mu.Lock()
+				done := consumed == len(seen)
+				mu.Unlock()
+				if done {
+					return
+				}
+				v, ok := q.Dequeue()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+				p := v / amount
+				assert.Greater(t, v, last[p])
+				last[p] = v
+				mu.Lock()
+				seen[v]++
+				consumed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	cwg.Wait()
+	for i, n := range seen {
+		require.Equal(t, 1, n, "element %d", i)
+	}
+}
+
+// TestSPSCStress checks the elements are dequeued in order, run it with -race.
+func TestSPSCStress(t *testing.T) {
+	const amount = 100000
+
+	q := NewSPSCOf[int](64)
+	go func() {
+		for i := 0; i < amount; i++ {
+			for !q.Enqueue(i) {
+				runtime.Gosched()
+			}
+		}
+	}()
+	for i := 0; i < amount; {
+		v, ok := q.Dequeue()
+		if !ok {
+			runtime.Gosched()
+			continue
+		}
+		require.Equal(t, i, v)
+		i++
+	}
+}
+
+const benchCap = 1024
+
+func benchmarkProducerConsumer(b *testing.B, producers int, enqueue func(int) bool, dequeue func() bool) {
+	var wg sync.WaitGroup
+	n := b.N / producers
+	b.ResetTimer()
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				for !enqueue(i) {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	for i := 0; i < n*producers; {
+		if dequeue() {
+			i++
+		} else {
+			runtime.Gosched()
+		}
+	}
+	wg.Wait()
+}
+
+func BenchmarkMPMC(b *testing.B) {
+	q := NewMPMCOf[int](benchCap)
+	benchmarkProducerConsumer(b, 4, q.Enqueue, func() bool { _, ok := q.Dequeue(); return ok })
+}
+
+func BenchmarkMutexQuickQueueMP(b *testing.B) {
+	var mu sync.Mutex
+	q := queue.NewQuickQueueOf[int]()
+	benchmarkProducerConsumer(b, 4,
+		func(v int) bool {
+			mu.Lock()
+			defer mu.Unlock()
+			if q.Len() >= benchCap {
+				return false
+			}
+			q.Add(v)
+			return true
+		},
+		func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			if q.IsEmpty() {
+				return false
+			}
+			q.Poll()
+			return true
+		})
+}
+
+func BenchmarkChannelMP(b *testing.B) {
+	ch := make(chan int, benchCap)
+	benchmarkProducerConsumer(b, 4,
+		func(v int) bool {
+			select {
+			case ch <- v:
+				return true
+			default:
+				return false
+			}
+		},
+		func() bool {
+			select {
+			case <-ch:
+				return true
+			default:
+				return false
+			}
+		})
+}
+
+func BenchmarkSPSC(b *testing.B) {
+	q := NewSPSCOf[int](benchCap)
+	benchmarkProducerConsumer(b, 1, q.Enqueue, func() bool { _, ok := q.Dequeue(); return ok })
+}
+
+func BenchmarkMutexQuickQueueSP(b *testing.B) {
+	var mu sync.Mutex
+	q := queue.NewQuickQueueOf[int]()
+	benchmarkProducerConsumer(b, 1,
+		func(v int) bool {
+			mu.Lock()
+			defer mu.Unlock()
+			if q.Len() >= benchCap {
+				return false
+			}
+			q.Add(v)
+			return true
+		},
+		func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			if q.IsEmpty() {
+				return false
+			}
+			q.Poll()
+			return true
+		})
+}
+
+func BenchmarkChannelSP(b *testing.B) {
+	ch := make(chan int, benchCap)
+	benchmarkProducerConsumer(b, 1,
+		func(v int) bool {
+			select {
+			case ch <- v:
+				return true
+			default:
+				return false
+			}
+		},
+		func() bool {
+			select {
+			case <-ch:
+				return true
+			default:
+				return false
+			}
+		})
+}
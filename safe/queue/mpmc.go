@@ -0,0 +1,129 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"sync/atomic"
+)
+
+// cacheLinePad pads the hot fields to their own cache lines to avoid false sharing.
+type cacheLinePad struct{ _ [64]byte }
+
+// roundCap returns the smallest power of two >= capacity, at least 2.
+func roundCap(capacity int) int {
+	n := 2
+	for n < capacity {
+		n <<= 1
+	}
+	return n
+}
+
+type cell[T any] struct {
+	seq atomic.Uint64
+	val T
+}
+
+// MPMC is a lock-free bounded multi-producer multi-consumer queue of interface{} elements.
+type MPMC = MPMCOf[interface{}]
+
+// MPMCOf is a lock-free bounded multi-producer multi-consumer FIFO queue of T elements,
+// based on Dmitry Vyukov's bounded MPMC queue, each cell has a sequence number
+// which tells the producers and the consumers whether it is ready for them.
+// Enqueue and Dequeue never block, they return false if the queue is full or empty.
+type MPMCOf[T any] struct {
+	_          cacheLinePad
+	enqueuePos atomic.Uint64
+	_          cacheLinePad
+	dequeuePos atomic.Uint64
+	_          cacheLinePad
+	mask       uint64
+	cells      []cell[T]
+}
+
+// NewMPMC creates a MPMC, the capacity is rounded up to a power of two.
+func NewMPMC(capacity int) *MPMC { return NewMPMCOf[interface{}](capacity) }
+
+// NewMPMCOf creates a MPMCOf of T elements, the capacity is rounded up to a power of two.
+func NewMPMCOf[T any](capacity int) *MPMCOf[T] {
+	n := roundCap(capacity)
+	sf := &MPMCOf[T]{
+		mask:  uint64(n - 1),
+		cells: make([]cell[T], n),
+	}
+	for i := range sf.cells {
+		sf.cells[i].seq.Store(uint64(i))
+	}
+	return sf
+}
+
+// Cap returns the capacity of this queue.
+func (sf *MPMCOf[T]) Cap() int { return len(sf.cells) }
+
+// Len returns the approximate number of elements in this queue.
+func (sf *MPMCOf[T]) Len() int {
+	head := sf.dequeuePos.Load() // load head first, so head <= tail
+	return min(int(sf.enqueuePos.Load()-head), len(sf.cells))
+}
+
+// Enqueue inserts an element into the tail of this queue, it returns false if this queue is full.
+func (sf *MPMCOf[T]) Enqueue(v T) bool {
+	var c *cell[T]
+
+	pos := sf.enqueuePos.Load()
+	for {
+		c = &sf.cells[pos&sf.mask]
+		dif := int64(c.seq.Load() - pos)
+		if dif == 0 { // the cell is free for pos
+			if sf.enqueuePos.CompareAndSwap(pos, pos+1) {
+				break
+			}
+			pos = sf.enqueuePos.Load()
+		} else if dif < 0 { // the cell is not consumed yet, full
+			return false
+		} else { // another producer took pos
+			pos = sf.enqueuePos.Load()
+		}
+	}
+	c.val = v
+	c.seq.Store(pos + 1)
+	return true
+}
+
+// Dequeue retrieves and removes the head of this queue, it returns false if this queue is empty.
+func (sf *MPMCOf[T]) Dequeue() (val T, ok bool) {
+	var c *cell[T]
+
+	pos := sf.dequeuePos.Load()
+	for {
+		c = &sf.cells[pos&sf.mask]
+		dif := int64(c.seq.Load() - (pos + 1))
+		if dif == 0 { // the cell is filled for pos
+			if sf.dequeuePos.CompareAndSwap(pos, pos+1) {
+				break
+			}
+			pos = sf.dequeuePos.Load()
+		} else if dif < 0 { // the cell is not produced yet, empty
+			return val, false
+		} else { // another consumer took pos
+			pos = sf.dequeuePos.Load()
+		}
+	}
+	var zero T
+
+	val = c.val
+	c.val = zero // should set zero for gc
+	c.seq.Store(pos + sf.mask + 1)
+	return val, true
+}
@@ -0,0 +1,83 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"sync/atomic"
+)
+
+// SPSC is a wait-free bounded single-producer single-consumer queue of interface{} elements.
+type SPSC = SPSCOf[interface{}]
+
+// SPSCOf is a wait-free bounded single-producer single-consumer FIFO queue of T elements based on a ring buffer.
+// Only one goroutine may call Enqueue and only one goroutine may call Dequeue at the same time,
+// Enqueue and Dequeue never block, they return false if the queue is full or empty.
+type SPSCOf[T any] struct {
+	_    cacheLinePad
+	head atomic.Uint64 // written by the consumer only
+	_    cacheLinePad
+	tail atomic.Uint64 // written by the producer only
+	_    cacheLinePad
+	mask uint64
+	buf  []T
+}
+
+// NewSPSC creates a SPSC, the capacity is rounded up to a power of two.
+func NewSPSC(capacity int) *SPSC { return NewSPSCOf[interface{}](capacity) }
+
+// NewSPSCOf creates a SPSCOf of T elements, the capacity is rounded up to a power of two.
+func NewSPSCOf[T any](capacity int) *SPSCOf[T] {
+	n := roundCap(capacity)
+	return &SPSCOf[T]{
+		mask: uint64(n - 1),
+		buf:  make([]T, n),
+	}
+}
+
+// Cap returns the capacity of this queue.
+func (sf *SPSCOf[T]) Cap() int { return len(sf.buf) }
+
+// Len returns the approximate number of elements in this queue.
+func (sf *SPSCOf[T]) Len() int {
+	head := sf.head.Load() // load head first, so head <= tail
+	return int(sf.tail.Load() - head)
+}
+
+// Enqueue inserts an element into the tail of this queue, it returns false if this queue is full.
+// It must be called by the producer only.
+func (sf *SPSCOf[T]) Enqueue(v T) bool {
+	tail := sf.tail.Load()
+	if tail-sf.head.Load() == uint64(len(sf.buf)) {
+		return false
+	}
+	sf.buf[tail&sf.mask] = v
+	sf.tail.Store(tail + 1)
+	return true
+}
+
+// Dequeue retrieves and removes the head of this queue, it returns false if this queue is empty.
+// It must be called by the consumer only.
+func (sf *SPSCOf[T]) Dequeue() (val T, ok bool) {
+	head := sf.head.Load()
+	if head == sf.tail.Load() {
+		return val, false
+	}
+	var zero T
+
+	val = sf.buf[head&sf.mask]
+	sf.buf[head&sf.mask] = zero // should set zero for gc
+	sf.head.Store(head + 1)
+	return val, true
+}
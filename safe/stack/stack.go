@@ -0,0 +1,115 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stack implements the thread-safe stacks.
+package stack
+
+import (
+	"context"
+	"sync"
+
+	"github.com/thinkgos/container"
+	"github.com/thinkgos/container/stack"
+)
+
+var _ container.Stack = (*Stack)(nil)
+
+// Stack is a thread-safe LIFO stack of interface{} elements.
+type Stack = StackOf[interface{}]
+
+// StackOf is a thread-safe LIFO stack of T elements, based on stack.QuickStackOf guarded by a mutex.
+type StackOf[T any] struct {
+	mu    sync.Mutex
+	items *stack.QuickStackOf[T]
+	// notEmpty is closed to wake up the waiters, it is created only when someone is waiting.
+	notEmpty chan struct{}
+}
+
+// New creates a Stack.
+func New() *Stack { return NewOf[interface{}]() }
+
+// NewOf creates a StackOf of T elements.
+func NewOf[T any]() *StackOf[T] {
+	return &StackOf[T]{items: stack.NewQuickStackOf[T]()}
+}
+
+// Len returns the length of this Stack.
+func (sf *StackOf[T]) Len() int {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	return sf.items.Len()
+}
+
+// IsEmpty returns true if this Stack contains no elements.
+func (sf *StackOf[T]) IsEmpty() bool { return sf.Len() == 0 }
+
+// Clear removes all the elements from this Stack.
+func (sf *StackOf[T]) Clear() {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	sf.items.Clear()
+}
+
+// Push pushes an element into this Stack, and wakes up the waiters of PopContext.
+func (sf *StackOf[T]) Push(val T) {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	sf.items.Push(val)
+	if sf.notEmpty != nil {
+		close(sf.notEmpty)
+		sf.notEmpty = nil
+	}
+}
+
+// Pop pops the element on the top of this Stack without waiting.
+// return the zero value if this Stack is empty.
+func (sf *StackOf[T]) Pop() T {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	return sf.items.Pop()
+}
+
+// Peek retrieves, but does not remove, the element on the top of this Stack,
+// or return the zero value if this Stack is empty.
+func (sf *StackOf[T]) Peek() T {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	return sf.items.Peek()
+}
+
+// PopContext pops the element on the top of this Stack, waiting until an element is available.
+// It returns ctx.Err() if ctx is done before an element is available.
+func (sf *StackOf[T]) PopContext(ctx context.Context) (T, error) {
+	sf.mu.Lock()
+	for {
+		if !sf.items.IsEmpty() {
+			v := sf.items.Pop()
+			sf.mu.Unlock()
+			return v, nil
+		}
+		if sf.notEmpty == nil {
+			sf.notEmpty = make(chan struct{})
+		}
+		ch := sf.notEmpty
+		sf.mu.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+		sf.mu.Lock()
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
+)
+
+func TestStack(t *testing.T) {
+	var s container.Stack = New()
+	assert.True(t, s.IsEmpty())
+	assert.Nil(t, s.Pop())
+	assert.Nil(t, s.Peek())
+
+	s.Push(1)
+	s.Push("hello")
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, "hello", s.Peek())
+	assert.Equal(t, "hello", s.Pop())
+	assert.Equal(t, 1, s.Pop())
+	s.Push(2)
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+}
+
+func TestStackPopContext(t *testing.T) {
+	s := NewOf[int]()
+	s.Push(1)
+	v, err := s.PopContext(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.PopContext(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// blocks until an element is pushed
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.Push(2)
+	}()
+	v, err = s.PopContext(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+}
+
+func TestStackConcurrent(t *testing.T) {
+	const producers, consumers, amount = 4, 4, 1000
+
+	s := NewOf[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	total := 0
+	var cwg sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		cwg.Add(1)
+		go func() {
+			defer cwg.Done()
+			for {
+				v, err := s.PopContext(ctx)
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				total += v
+				if total == producers*amount {
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < amount; i++ {
+				s.Push(1)
+			}
+		}()
+	}
+	wg.Wait()
+	cwg.Wait()
+	assert.Equal(t, producers*amount, total)
+	assert.True(t, s.IsEmpty())
+}
@@ -0,0 +1,86 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"sync/atomic"
+)
+
+type node[T any] struct {
+	val  T
+	next *node[T]
+}
+
+// Treiber is a lock-free LIFO stack of interface{} elements.
+type Treiber = TreiberOf[interface{}]
+
+// TreiberOf is a lock-free LIFO stack of T elements, known as Treiber stack,
+// the top is swapped by compare-and-swap on an atomic pointer.
+//
+// It is ABA-safe: every Push allocates a new node and the nodes are never reused,
+// the garbage collector does not free a node while any goroutine still holds it,
+// so a top pointer compared equal always refers to the same node.
+// The zero value is an empty stack ready to use.
+type TreiberOf[T any] struct {
+	top  atomic.Pointer[node[T]]
+	size atomic.Int64
+}
+
+// NewTreiber creates a Treiber.
+func NewTreiber() *Treiber { return NewTreiberOf[interface{}]() }
+
+// NewTreiberOf creates a TreiberOf of T elements.
+func NewTreiberOf[T any]() *TreiberOf[T] { return &TreiberOf[T]{} }
+
+// Len returns the approximate number of elements in this stack.
+func (sf *TreiberOf[T]) Len() int { return int(max(sf.size.Load(), 0)) }
+
+// IsEmpty returns true if this stack contains no elements.
+func (sf *TreiberOf[T]) IsEmpty() bool { return sf.top.Load() == nil }
+
+// Push pushes an element into this stack.
+func (sf *TreiberOf[T]) Push(val T) {
+	n := &node[T]{val: val}
+	for {
+		n.next = sf.top.Load()
+		if sf.top.CompareAndSwap(n.next, n) {
+			sf.size.Add(1)
+			return
+		}
+	}
+}
+
+// Pop pops the element on the top of this stack, it returns false if this stack is empty.
+func (sf *TreiberOf[T]) Pop() (val T, ok bool) {
+	for {
+		top := sf.top.Load()
+		if top == nil {
+			return val, false
+		}
+		if sf.top.CompareAndSwap(top, top.next) {
+			sf.size.Add(-1)
+			return top.val, true
+		}
+	}
+}
+
+// Peek retrieves, but does not remove, the element on the top of this stack,
+// it returns false if this stack is empty.
+func (sf *TreiberOf[T]) Peek() (val T, ok bool) {
+	if top := sf.top.Load(); top != nil {
+		return top.val, true
+	}
+	return val, false
+}
@@ -0,0 +1,134 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container/stack"
+)
+
+func TestTreiber(t *testing.T) {
+	var s TreiberOf[int] // zero value is ready to use
+	assert.True(t, s.IsEmpty())
+	_, ok := s.Pop()
+	assert.False(t, ok)
+	_, ok = s.Peek()
+	assert.False(t, ok)
+
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	assert.Equal(t, 3, s.Len())
+	v, ok := s.Peek()
+	require.True(t, ok)
+	assert.Equal(t, 3, v)
+	for i := 3; i > 0; i-- {
+		v, ok = s.Pop()
+		require.True(t, ok)
+		assert.Equal(t, i, v)
+	}
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 0, s.Len())
+
+	ts := NewTreiber()
+	ts.Push(nil)
+	v2, ok := ts.Pop()
+	assert.True(t, ok)
+	assert.Nil(t, v2)
+}
+
+// TestTreiberStress checks every element is popped exactly once, run it with -race.
+func TestTreiberStress(t *testing.T) {
+	const goroutines, amount = 8, 5000
+
+	s := NewTreiberOf[int]()
+	seen := make([]int32, goroutines*amount)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < amount; i++ {
+				s.Push(g*amount + i)
+				if i%2 == 1 { // pop half of them concurrently
+					if v, ok := s.Pop(); ok {
+						mu.Lock()
+						seen[v]++
+						mu.Unlock()
+					}
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	for {
+		v, ok := s.Pop()
+		if !ok {
+			break
+		}
+		seen[v]++
+	}
+	for i, n := range seen {
+		require.Equal(t, int32(1), n, "element %d", i)
+	}
+	assert.Equal(t, 0, s.Len())
+}
+
+func BenchmarkTreiber(b *testing.B) {
+	s := NewTreiberOf[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Push(1)
+			s.Pop()
+		}
+	})
+}
+
+func BenchmarkStack(b *testing.B) {
+	s := NewOf[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Push(1)
+			s.Pop()
+		}
+	})
+}
+
+func BenchmarkMutexQuickStack(b *testing.B) {
+	var mu sync.Mutex
+	s := stack.NewQuickStackOf[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mu.Lock()
+			s.Push(1)
+			s.Pop()
+			mu.Unlock()
+		}
+	})
+}
+
+func BenchmarkQuickStack(b *testing.B) {
+	s := stack.NewQuickStackOf[int]()
+	for i := 0; i < b.N; i++ {
+		s.Push(1)
+		s.Pop()
+	}
+}
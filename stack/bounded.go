@@ -0,0 +1,136 @@
+package stack
+
+import (
+	"iter"
+
+	"github.com/thinkgos/container"
+)
+
+var _ container.Stack = (*BoundedStack)(nil)
+
+// BoundedStack is a fixed-capacity LIFO stack of interface{} elements.
+type BoundedStack = BoundedStackOf[interface{}]
+
+// BoundedStackOf is a fixed-capacity LIFO stack of T elements implement with a ring buffer,
+// pushing onto a full stack evicts the bottom element, like an undo history.
+// All the operations at both ends are O(1).
+type BoundedStackOf[T any] struct {
+	buf     []T
+	bottom  int
+	size    int
+	onEvict func(T)
+}
+
+// NewBoundedStack creates a BoundedStack with the capacity, onEvict is called with the evicted element if it is not nil.
+// It panics if capacity <= 0.
+func NewBoundedStack(capacity int, onEvict func(interface{})) *BoundedStack {
+	return NewBoundedStackOf[interface{}](capacity, onEvict)
+}
+
+// NewBoundedStackOf creates a BoundedStackOf of T elements with the capacity,
+// onEvict is called with the evicted element if it is not nil.
+// It panics if capacity <= 0, like queue.NewRingOf, a non-positive capacity is a programming error,
+// use NewQuickStackOf with WithCapacity if the capacity may be zero, which means unbounded there.
+func NewBoundedStackOf[T any](capacity int, onEvict func(T)) *BoundedStackOf[T] {
+	if capacity <= 0 {
+		panic("stack: bounded stack capacity must be positive")
+	}
+	return &BoundedStackOf[T]{buf: make([]T, capacity), onEvict: onEvict}
+}
+
+// Cap returns the capacity of this BoundedStack.
+func (sf *BoundedStackOf[T]) Cap() int { return len(sf.buf) }
+
+// Len returns the length of this BoundedStack.
+func (sf *BoundedStackOf[T]) Len() int { return sf.size }
+
+// IsEmpty returns true if this BoundedStack contains no elements.
+func (sf *BoundedStackOf[T]) IsEmpty() bool { return sf.size == 0 }
+
+// Clear removes all the elements from this BoundedStack, the buffer is kept.
+func (sf *BoundedStackOf[T]) Clear() {
+	clear(sf.buf) // should set zero for gc
+	sf.bottom, sf.size = 0, 0
+}
+
+// Push pushes an element into this BoundedStack,
+// if it is full, the bottom element is evicted and passed to the eviction callback.
+func (sf *BoundedStackOf[T]) Push(val T) {
+	if sf.size < len(sf.buf) {
+		sf.buf[sf.index(sf.size)] = val
+		sf.size++
+		return
+	}
+	evicted := sf.buf[sf.bottom]
+	sf.buf[sf.bottom] = val
+	sf.bottom = sf.index(1)
+	if sf.onEvict != nil {
+		sf.onEvict(evicted)
+	}
+}
+
+// Pop pops the element on the top of this BoundedStack.
+// return the zero value if this BoundedStack is empty.
+func (sf *BoundedStackOf[T]) Pop() (val T) {
+	if sf.size > 0 {
+		var zero T
+
+		top := sf.index(sf.size - 1)
+		val = sf.buf[top]
+		sf.buf[top] = zero // should set zero for gc
+		sf.size--
+	}
+	return val
+}
+
+// Peek retrieves, but does not remove, the element on the top of this BoundedStack,
+// or return the zero value if this BoundedStack is empty.
+func (sf *BoundedStackOf[T]) Peek() (val T) {
+	if sf.size > 0 {
+		val = sf.buf[sf.index(sf.size-1)]
+	}
+	return val
+}
+
+// Values returns a copy of all the elements from top to bottom.
+func (sf *BoundedStackOf[T]) Values() []T {
+	values := make([]T, 0, sf.size)
+	sf.Iterator(func(v T) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+// Iterator returns an iterator over the elements in this BoundedStack from top to bottom.
+func (sf *BoundedStackOf[T]) Iterator(f func(T) bool) {
+	for i := sf.size - 1; i >= 0; i-- {
+		if f == nil || !f(sf.buf[sf.index(i)]) {
+			return
+		}
+	}
+}
+
+// ReverseIterator returns an iterator over the elements in this BoundedStack from bottom to top as Iterator.
+func (sf *BoundedStackOf[T]) ReverseIterator(f func(T) bool) {
+	for i := 0; i < sf.size; i++ {
+		if f == nil || !f(sf.buf[sf.index(i)]) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the elements in this BoundedStack from top to bottom.
+func (sf *BoundedStackOf[T]) All() iter.Seq[T] { return sf.Iterator }
+
+// Backward returns an iterator over the elements in this BoundedStack from bottom to top.
+func (sf *BoundedStackOf[T]) Backward() iter.Seq[T] { return sf.ReverseIterator }
+
+// index returns the position in the buffer of the i-th element from the bottom.
+func (sf *BoundedStackOf[T]) index(i int) int {
+	i += sf.bottom
+	if i >= len(sf.buf) {
+		i -= len(sf.buf)
+	}
+	return i
+}
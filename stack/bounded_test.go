@@ -0,0 +1,76 @@
+package stack
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thinkgos/container"
+)
+
+func TestBoundedStack(t *testing.T) {
+	assert.Panics(t, func() { NewBoundedStack(0, nil) })
+
+	var evicted []interface{}
+	var s container.Stack = NewBoundedStack(3, func(v interface{}) { evicted = append(evicted, v) })
+	bs := s.(*BoundedStack)
+	assert.Equal(t, 3, bs.Cap())
+	assert.True(t, s.IsEmpty())
+	assert.Nil(t, s.Pop())
+	assert.Nil(t, s.Peek())
+
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	assert.Empty(t, evicted)
+	s.Push(4)
+	s.Push(5)
+	assert.Equal(t, []interface{}{1, 2}, evicted)
+	assert.Equal(t, 3, s.Len())
+	assert.Equal(t, []interface{}{5, 4, 3}, bs.Values())
+	assert.Equal(t, []interface{}{3, 4, 5}, slices.Collect(bs.Backward()))
+
+	assert.Equal(t, 5, s.Peek())
+	assert.Equal(t, 5, s.Pop())
+	s.Push(6) // not full, no eviction
+	assert.Equal(t, []interface{}{1, 2}, evicted)
+	s.Push(7)
+	assert.Equal(t, []interface{}{1, 2, 3}, evicted)
+	assert.Equal(t, []interface{}{7, 6, 4}, slices.Collect(bs.All()))
+
+	var got []interface{}
+	bs.Iterator(func(v interface{}) bool {
+		got = append(got, v)
+		return v != 6
+	})
+	assert.Equal(t, []interface{}{7, 6}, got)
+	got = got[:0]
+	bs.ReverseIterator(func(v interface{}) bool {
+		got = append(got, v)
+		return v != 6
+	})
+	assert.Equal(t, []interface{}{4, 6}, got)
+	bs.Iterator(nil)
+
+	assert.Equal(t, 7, s.Pop())
+	assert.Equal(t, 6, s.Pop())
+	assert.Equal(t, 4, s.Pop())
+	assert.Nil(t, s.Pop())
+
+	s.Push(8)
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.Empty(t, bs.Values())
+}
+
+func TestBoundedStackOf(t *testing.T) {
+	s := NewBoundedStackOf[string](2, nil)
+	s.Push("a")
+	s.Push("b")
+	s.Push("c")
+	assert.Equal(t, []string{"c", "b"}, s.Values())
+	assert.Equal(t, "c", s.Pop())
+	assert.Equal(t, "b", s.Pop())
+	assert.Equal(t, "", s.Pop())
+}
@@ -0,0 +1,113 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"container/list"
+	"slices"
+
+	"github.com/thinkgos/container"
+)
+
+// MarshalJSON implement json.Marshaler, the elements are encoded as a JSON array from bottom to top.
+// The elements of interface type are encoded as container.JSONValue.
+func (sf *StackOf[T]) MarshalJSON() ([]byte, error) {
+	return container.MarshalJSONValues(sf.values())
+}
+
+// UnmarshalJSON implement json.Unmarshaler, it replaces the elements of this Stack.
+func (sf *StackOf[T]) UnmarshalJSON(data []byte) error {
+	values, err := container.UnmarshalJSONValues[T](data)
+	if err != nil {
+		return err
+	}
+	sf.reset(values)
+	return nil
+}
+
+// MarshalBinary implement encoding.BinaryMarshaler, the elements are encoded by gob from bottom to top.
+func (sf *StackOf[T]) MarshalBinary() ([]byte, error) { return container.GobEncode(sf.values()) }
+
+// UnmarshalBinary implement encoding.BinaryUnmarshaler, it replaces the elements of this Stack.
+func (sf *StackOf[T]) UnmarshalBinary(data []byte) error {
+	var values []T
+	if err := container.GobDecode(data, &values); err != nil {
+		return err
+	}
+	sf.reset(values)
+	return nil
+}
+
+// GobEncode implement gob.GobEncoder.
+func (sf *StackOf[T]) GobEncode() ([]byte, error) { return sf.MarshalBinary() }
+
+// GobDecode implement gob.GobDecoder.
+func (sf *StackOf[T]) GobDecode(data []byte) error { return sf.UnmarshalBinary(data) }
+
+// values returns the elements from bottom to top.
+func (sf *StackOf[T]) values() []T {
+	if sf.ll == nil {
+		return nil
+	}
+	return slices.Collect(sf.Backward())
+}
+
+// reset replaces the elements with values from bottom to top, it initializes the Stack if it is the zero value.
+func (sf *StackOf[T]) reset(values []T) {
+	if sf.ll == nil {
+		sf.ll = list.New()
+	}
+	sf.ll.Init()
+	for _, v := range values {
+		sf.ll.PushFront(v)
+	}
+}
+
+// MarshalJSON implement json.Marshaler, the elements are encoded as a JSON array from bottom to top.
+// The elements of interface type are encoded as container.JSONValue.
+func (sf *QuickStackOf[T]) MarshalJSON() ([]byte, error) {
+	return container.MarshalJSONValues(sf.values())
+}
+
+// UnmarshalJSON implement json.Unmarshaler, it replaces the elements of this QuickStack,
+// a bounded QuickStack keeps the top elements within its capacity.
+func (sf *QuickStackOf[T]) UnmarshalJSON(data []byte) error {
+	items, err := container.UnmarshalJSONValues[T](data)
+	if err != nil {
+		return err
+	}
+	sf.reset(items)
+	return nil
+}
+
+// MarshalBinary implement encoding.BinaryMarshaler, the elements are encoded by gob from bottom to top.
+func (sf *QuickStackOf[T]) MarshalBinary() ([]byte, error) { return container.GobEncode(sf.values()) }
+
+// UnmarshalBinary implement encoding.BinaryUnmarshaler, it replaces the elements of this QuickStack,
+// a bounded QuickStack keeps the top elements within its capacity.
+func (sf *QuickStackOf[T]) UnmarshalBinary(data []byte) error {
+	var items []T
+	if err := container.GobDecode(data, &items); err != nil {
+		return err
+	}
+	sf.reset(items)
+	return nil
+}
+
+// GobEncode implement gob.GobEncoder.
+func (sf *QuickStackOf[T]) GobEncode() ([]byte, error) { return sf.MarshalBinary() }
+
+// GobDecode implement gob.GobDecoder.
+func (sf *QuickStackOf[T]) GobDecode(data []byte) error { return sf.UnmarshalBinary(data) }
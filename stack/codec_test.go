@@ -0,0 +1,100 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackCodec(t *testing.T) {
+	s := New()
+	s.Push(1)
+	s.Push("hello")
+	s.Push(int64(2))
+	s.Push(nil)
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	got := New()
+	got.Push("replaced")
+	require.NoError(t, json.Unmarshal(data, got))
+	assert.Equal(t, slices.Collect(s.All()), slices.Collect(got.All()))
+
+	data, err = s.MarshalBinary()
+	require.NoError(t, err)
+	got = New()
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, slices.Collect(s.All()), slices.Collect(got.All()))
+
+	// gob with the zero value
+	si := NewOf[int]()
+	si.Push(1)
+	si.Push(2)
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(si))
+	var gotInt StackOf[int]
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&gotInt))
+	assert.Equal(t, 2, gotInt.Pop())
+	assert.Equal(t, 1, gotInt.Pop())
+
+	data, err = json.Marshal(si)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[1,2]`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), got))
+	assert.Error(t, got.UnmarshalBinary([]byte("bad")))
+}
+
+func TestQuickStackCodec(t *testing.T) {
+	s := NewQuickStack()
+	s.Push(1)
+	s.Push("hello")
+	s.Push(int64(2))
+	s.Push(nil)
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	got := NewQuickStack()
+	got.Push("replaced")
+	require.NoError(t, json.Unmarshal(data, got))
+	assert.Equal(t, slices.Collect(s.All()), slices.Collect(got.All()))
+
+	data, err = s.MarshalBinary()
+	require.NoError(t, err)
+	got = NewQuickStack()
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, slices.Collect(s.All()), slices.Collect(got.All()))
+
+	// gob with the zero value
+	si := NewQuickStackOf[int]()
+	si.Push(1)
+	si.Push(2)
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(si))
+	var gotInt QuickStackOf[int]
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&gotInt))
+	assert.Equal(t, 2, gotInt.Pop())
+	assert.Equal(t, 1, gotInt.Pop())
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), got))
+	assert.Error(t, got.UnmarshalBinary([]byte("bad")))
+}
@@ -0,0 +1,39 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/thinkgos/container"
+	"github.com/thinkgos/container/containertest"
+)
+
+// MonotonicStack drops the elements which break the monotonicity, so it is not a plain LIFO stack.
+func TestConformance(t *testing.T) {
+	t.Run("Stack", func(t *testing.T) {
+		containertest.TestStack(t, func() container.StackOf[int] { return NewOf[int]() })
+	})
+	t.Run("QuickStack", func(t *testing.T) {
+		containertest.TestStack(t, func() container.StackOf[int] { return NewQuickStackOf[int]() })
+	})
+	t.Run("QuickStackCapacity", func(t *testing.T) {
+		containertest.TestStack(t, func() container.StackOf[int] { return NewQuickStackOf[int](WithCapacity(1024)) })
+	})
+	t.Run("BoundedStack", func(t *testing.T) {
+		// the capacity is larger than the steps of the model test, so no element is evicted.
+		containertest.TestStack(t, func() container.StackOf[int] { return NewBoundedStackOf[int](1024, nil) })
+	})
+}
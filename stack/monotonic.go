@@ -0,0 +1,128 @@
+package stack
+
+import (
+	"iter"
+
+	"github.com/thinkgos/container"
+	"github.com/thinkgos/container/comparator"
+)
+
+var _ container.Stack = (*MonotonicStack)(nil)
+
+// monotonicItem is an element with the min and max of the elements from the bottom to it.
+type monotonicItem[T any] struct {
+	val T
+	min T
+	max T
+}
+
+// MonotonicStack is a LIFO stack of interface{} elements which tracks the min and max elements.
+type MonotonicStack = MonotonicStackOf[interface{}]
+
+// MonotonicStackOf is a LIFO stack of T elements implement with slice,
+// which tracks the min and max elements in O(1) per operation.
+// Each element keeps the min and max of the elements below it, so Min and Max are always the top's.
+type MonotonicStackOf[T any] struct {
+	items []monotonicItem[T]
+	cmp   comparator.CompareFunc[T]
+}
+
+// NewMonotonicStack creates a MonotonicStack with the Comparator,
+// if c is nil, the elements are compared by their natural ordering.
+func NewMonotonicStack(c comparator.Comparator) *MonotonicStack {
+	return NewMonotonicStackOf[interface{}](c)
+}
+
+// NewMonotonicStackOf creates a MonotonicStackOf of T elements with the Comparator,
+// if c is nil, the elements are compared by their natural ordering, it panics if T has none.
+func NewMonotonicStackOf[T any](c comparator.Comparator) *MonotonicStackOf[T] {
+	return &MonotonicStackOf[T]{cmp: comparator.OrderOf(comparator.FuncOf[T](c))}
+}
+
+// Len returns the length of this MonotonicStack.
+func (sf *MonotonicStackOf[T]) Len() int { return len(sf.items) }
+
+// IsEmpty returns true if this MonotonicStack contains no elements.
+func (sf *MonotonicStackOf[T]) IsEmpty() bool { return len(sf.items) == 0 }
+
+// Clear removes all the elements from this MonotonicStack.
+func (sf *MonotonicStackOf[T]) Clear() { sf.items = nil } // should set nil for gc
+
+// Push pushes an element into this MonotonicStack.
+func (sf *MonotonicStackOf[T]) Push(val T) {
+	item := monotonicItem[T]{val, val, val}
+	if length := len(sf.items); length > 0 {
+		top := sf.items[length-1]
+		if sf.compare(top.min, val) <= 0 {
+			item.min = top.min
+		}
+		if sf.compare(top.max, val) >= 0 {
+			item.max = top.max
+		}
+	}
+	sf.items = append(sf.items, item)
+}
+
+// Pop pops the element on the top of this MonotonicStack.
+// return the zero value if this MonotonicStack is empty.
+func (sf *MonotonicStackOf[T]) Pop() (val T) {
+	if length := len(sf.items); length > 0 {
+		val = sf.items[length-1].val
+		sf.items[length-1] = monotonicItem[T]{} // should set zero for gc
+		sf.items = sf.items[:length-1]
+	}
+	return val
+}
+
+// Peek retrieves, but does not remove, the element on the top of this MonotonicStack,
+// or return the zero value if this MonotonicStack is empty.
+func (sf *MonotonicStackOf[T]) Peek() (val T) {
+	if len(sf.items) > 0 {
+		val = sf.items[len(sf.items)-1].val
+	}
+	return val
+}
+
+// Min returns the minimum element in this MonotonicStack, the earliest pushed one if there are equal elements,
+// or return the zero value if this MonotonicStack is empty.
+func (sf *MonotonicStackOf[T]) Min() (val T) {
+	if len(sf.items) > 0 {
+		val = sf.items[len(sf.items)-1].min
+	}
+	return val
+}
+
+// Max returns the maximum element in this MonotonicStack, the earliest pushed one if there are equal elements,
+// or return the zero value if this MonotonicStack is empty.
+func (sf *MonotonicStackOf[T]) Max() (val T) {
+	if len(sf.items) > 0 {
+		val = sf.items[len(sf.items)-1].max
+	}
+	return val
+}
+
+// All returns an iterator over the elements in this MonotonicStack from top to bottom.
+func (sf *MonotonicStackOf[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(sf.items) - 1; i >= 0; i-- {
+			if !yield(sf.items[i].val) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements in this MonotonicStack from bottom to top.
+func (sf *MonotonicStackOf[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range sf.items {
+			if !yield(item.val) {
+				return
+			}
+		}
+	}
+}
+
+func (sf *MonotonicStackOf[T]) compare(v1, v2 T) int {
+	return sf.cmp(v1, v2)
+}
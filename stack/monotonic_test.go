@@ -0,0 +1,73 @@
+package stack
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thinkgos/container"
+	"github.com/thinkgos/container/comparator"
+)
+
+func TestMonotonicStack(t *testing.T) {
+	var s container.Stack = NewMonotonicStack(nil)
+	ms := s.(*MonotonicStack)
+	assert.True(t, s.IsEmpty())
+	assert.Nil(t, ms.Min())
+	assert.Nil(t, ms.Max())
+	assert.Nil(t, s.Pop())
+	assert.Nil(t, s.Peek())
+
+	steps := []struct {
+		push     int
+		min, max int
+	}{
+		{5, 5, 5},
+		{3, 3, 5},
+		{7, 3, 7},
+		{3, 3, 7},
+		{1, 1, 7},
+		{9, 1, 9},
+	}
+	for _, step := range steps {
+		s.Push(step.push)
+		assert.Equal(t, step.min, ms.Min())
+		assert.Equal(t, step.max, ms.Max())
+	}
+	assert.Equal(t, len(steps), s.Len())
+	for i := len(steps) - 1; i > 0; i-- {
+		assert.Equal(t, steps[i].push, s.Peek())
+		assert.Equal(t, steps[i].push, s.Pop())
+		assert.Equal(t, steps[i-1].min, ms.Min())
+		assert.Equal(t, steps[i-1].max, ms.Max())
+	}
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.Nil(t, ms.Min())
+}
+
+func TestMonotonicStackOf(t *testing.T) {
+	s := NewMonotonicStackOf[string](comparator.CompareFunc[string](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}))
+	s.Push("b")
+	s.Push("A")
+	s.Push("a") // equal, the earliest is kept
+	s.Push("C")
+	assert.Equal(t, "A", s.Min())
+	assert.Equal(t, "C", s.Max())
+	assert.Equal(t, []string{"C", "a", "A", "b"}, slices.Collect(s.All()))
+	assert.Equal(t, []string{"b", "A", "a", "C"}, slices.Collect(s.Backward()))
+
+	assert.Equal(t, "C", s.Pop())
+	assert.Equal(t, "b", s.Max())
+	assert.Equal(t, "a", s.Pop())
+	assert.Equal(t, "A", s.Min())
+	s.Pop()
+	assert.Equal(t, "b", s.Min())
+	s.Pop()
+	assert.Equal(t, "", s.Min())
+	assert.Equal(t, "", s.Max())
+}
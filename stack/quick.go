@@ -1,49 +1,201 @@
 package stack
 
 import (
+	"fmt"
+	"iter"
+	"slices"
+
 	"github.com/thinkgos/container"
 )
 
 var _ container.Stack = (*QuickStack)(nil)
 
-// QuickStack is quick LIFO stack implement with slice.
-type QuickStack struct {
-	items []interface{}
+type options struct {
+	capacity int
+	onEvict  interface{}
+}
+
+// Option option for NewQuickStack.
+type Option func(o *options)
+
+// WithCapacity with the capacity of the QuickStack, pushing onto a full stack evicts the bottom element,
+// like an undo history. A bounded QuickStack is based on a ring buffer, so both ends are O(1).
+// Zero or negative means unbounded, default unbounded.
+func WithCapacity(capacity int) Option {
+	return func(o *options) {
+		o.capacity = max(capacity, 0)
+	}
+}
+
+// WithOnEvict with the callback of the QuickStackOf[T], it is called with the evicted bottom element,
+// see WithCapacity. It panics on a QuickStack of other elements.
+func WithOnEvict[T any](f func(T)) Option {
+	return func(o *options) {
+		o.onEvict = f
+	}
+}
+
+// QuickStack is quick LIFO stack implement with slice of interface{} elements.
+type QuickStack = QuickStackOf[interface{}]
+
+// QuickStackOf is quick LIFO stack implement with slice of T elements.
+// It is unbounded by default, or based on a ring buffer with the capacity, see WithCapacity.
+type QuickStackOf[T any] struct {
+	items []T
+	// ring holds the elements of a bounded QuickStack, items is unused then.
+	ring *BoundedStackOf[T]
 }
 
 // NewQuickStack creates a QuickStack. which implement interface stack.Interface.
-func NewQuickStack() *QuickStack { return &QuickStack{} }
+func NewQuickStack(opts ...Option) *QuickStack { return NewQuickStackOf[interface{}](opts...) }
+
+// NewQuickStackOf creates a QuickStackOf of T elements. which implement interface stack.Interface.
+// It panics if WithOnEvict is given a callback of other elements.
+func NewQuickStackOf[T any](opts ...Option) *QuickStackOf[T] {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var onEvict func(T)
+	if o.onEvict != nil {
+		f, ok := o.onEvict.(func(T))
+		if !ok {
+			panic(fmt.Sprintf("stack: WithOnEvict of %T can't be used with %T", o.onEvict, (*QuickStackOf[T])(nil)))
+		}
+		onEvict = f
+	}
+	if o.capacity == 0 {
+		return &QuickStackOf[T]{}
+	}
+	return &QuickStackOf[T]{ring: NewBoundedStackOf[T](o.capacity, onEvict)}
+}
+
+// Cap returns the capacity of this QuickStack, zero means unbounded.
+func (sf *QuickStackOf[T]) Cap() int {
+	if sf.ring != nil {
+		return sf.ring.Cap()
+	}
+	return 0
+}
 
 // Len returns the length of this priority queue.
-func (sf *QuickStack) Len() int { return len(sf.items) }
+func (sf *QuickStackOf[T]) Len() int {
+	if sf.ring != nil {
+		return sf.ring.Len()
+	}
+	return len(sf.items)
+}
 
 // IsEmpty returns true if this QuickStack contains no elements.
-func (sf *QuickStack) IsEmpty() bool { return len(sf.items) == 0 }
+func (sf *QuickStackOf[T]) IsEmpty() bool { return sf.Len() == 0 }
 
 // Clear removes all the elements from this QuickStack.
-func (sf *QuickStack) Clear() { sf.items = nil } // should set nil for gc
+func (sf *QuickStackOf[T]) Clear() {
+	if sf.ring != nil {
+		sf.ring.Clear()
+		return
+	}
+	sf.items = nil // should set nil for gc
+}
 
 // Push push an element into this QuickStack.
-func (sf *QuickStack) Push(val interface{}) { sf.items = append(sf.items, val) }
+// If this QuickStack is bounded and full, the bottom element is evicted, see WithCapacity.
+func (sf *QuickStackOf[T]) Push(val T) {
+	if sf.ring != nil {
+		sf.ring.Push(val)
+		return
+	}
+	sf.items = append(sf.items, val)
+}
 
 // Pop pop the element on the top of this QuickStack.
-// return nil if this QuickStack is empty.
-func (sf *QuickStack) Pop() interface{} {
+// return the zero value if this QuickStack is empty.
+func (sf *QuickStackOf[T]) Pop() (val T) {
+	if sf.ring != nil {
+		return sf.ring.Pop()
+	}
 	if length := len(sf.items); length > 0 {
-		val := sf.items[length-1]
-		sf.items[length-1] = nil // should set nil for gc
+		var zero T
+
+		val = sf.items[length-1]
+		sf.items[length-1] = zero // should set zero for gc
 		sf.items = sf.items[:length-1]
-		return val
 	}
-	return nil
+	return val
 }
 
 // Peek retrieves, but does not remove,
 // the element on the top of this QuickStack,
-// or return nil if this QuickStack is empty.
-func (sf *QuickStack) Peek() interface{} {
+// or return the zero value if this QuickStack is empty.
+func (sf *QuickStackOf[T]) Peek() (val T) {
+	if sf.ring != nil {
+		return sf.ring.Peek()
+	}
 	if len(sf.items) > 0 {
-		return sf.items[len(sf.items)-1]
+		val = sf.items[len(sf.items)-1]
+	}
+	return val
+}
+
+// Values returns a copy of all the elements from top to bottom.
+func (sf *QuickStackOf[T]) Values() []T {
+	values := make([]T, 0, sf.Len())
+	sf.Iterator(func(v T) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+// Iterator returns an iterator over the elements in this QuickStack from top to bottom.
+func (sf *QuickStackOf[T]) Iterator(f func(T) bool) {
+	if sf.ring != nil {
+		sf.ring.Iterator(f)
+		return
+	}
+	for i := len(sf.items) - 1; i >= 0; i-- {
+		if f == nil || !f(sf.items[i]) {
+			return
+		}
+	}
+}
+
+// ReverseIterator returns an iterator over the elements in this QuickStack from bottom to top as Iterator.
+func (sf *QuickStackOf[T]) ReverseIterator(f func(T) bool) {
+	if sf.ring != nil {
+		sf.ring.ReverseIterator(f)
+		return
+	}
+	for _, v := range sf.items {
+		if f == nil || !f(v) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the elements in this QuickStack from top to bottom.
+func (sf *QuickStackOf[T]) All() iter.Seq[T] { return sf.Iterator }
+
+// Backward returns an iterator over the elements in this QuickStack from bottom to top.
+func (sf *QuickStackOf[T]) Backward() iter.Seq[T] { return sf.ReverseIterator }
+
+// values returns the elements from bottom to top.
+func (sf *QuickStackOf[T]) values() []T {
+	if sf.ring != nil {
+		return slices.Collect(sf.ring.Backward())
+	}
+	return sf.items
+}
+
+// reset replaces the elements with items from bottom to top,
+// a bounded QuickStack keeps the top elements within the capacity, nothing is passed to the eviction callback.
+func (sf *QuickStackOf[T]) reset(items []T) {
+	if sf.ring == nil {
+		sf.items = items
+		return
+	}
+	sf.ring.Clear()
+	for _, v := range items[max(len(items)-sf.ring.Cap(), 0):] {
+		sf.ring.Push(v)
 	}
-	return nil
 }
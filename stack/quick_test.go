@@ -1,9 +1,14 @@
 package stack
 
 import (
+	"encoding/json"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thinkgos/container"
 )
 
 func TestQuickStack(t *testing.T) {
@@ -46,3 +51,64 @@ func TestQuickStack(t *testing.T) {
 	assert.True(t, s.IsEmpty())
 	assert.Zero(t, s.Len())
 }
+
+func TestQuickStackOf(t *testing.T) {
+	var s container.StackOf[string] = NewQuickStackOf[string]()
+	s.Push("hello")
+	s.Push("world")
+
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, "world", s.Peek())
+	assert.Equal(t, "world", s.Pop())
+	assert.Equal(t, "hello", s.Pop())
+	assert.Zero(t, s.Pop())
+	assert.Zero(t, s.Peek())
+	assert.True(t, s.IsEmpty())
+}
+
+func TestQuickStackAll(t *testing.T) {
+	s := NewQuickStackOf[int]()
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(s.All()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(s.Backward()))
+	assert.Equal(t, 3, s.Len())
+}
+
+func TestQuickStackCapacity(t *testing.T) {
+	var evicted []int
+	s := NewQuickStackOf[int](WithCapacity(3), WithOnEvict(func(v int) { evicted = append(evicted, v) }))
+	assert.Equal(t, 3, s.Cap())
+	for i := 1; i <= 5; i++ {
+		s.Push(i)
+	}
+	assert.Equal(t, []int{1, 2}, evicted)
+	assert.Equal(t, 3, s.Len())
+	assert.Equal(t, []int{5, 4, 3}, s.Values())
+	assert.Equal(t, []int{3, 4, 5}, slices.Collect(s.Backward()))
+	assert.Equal(t, 5, s.Peek())
+	assert.Equal(t, 5, s.Pop())
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[3,4]`, string(data))
+	d := NewQuickStackOf[int](WithCapacity(1))
+	require.NoError(t, json.Unmarshal(data, d))
+	assert.Equal(t, []int{4}, d.Values())
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.Zero(t, s.Pop())
+
+	// zero or negative is unbounded
+	for _, c := range []int{0, -1} {
+		u := NewQuickStackOf[int](WithCapacity(c))
+		assert.Zero(t, u.Cap())
+		for i := 0; i < 100; i++ {
+			u.Push(i)
+		}
+		assert.Equal(t, 100, u.Len())
+	}
+	assert.Panics(t, func() { NewQuickStackOf[int](WithOnEvict(func(string) {})) })
+}
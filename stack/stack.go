@@ -17,45 +17,77 @@ package stack
 
 import (
 	"container/list"
+	"iter"
 
 	"github.com/thinkgos/container"
 )
 
 var _ container.Stack = (*Stack)(nil)
 
-// Stack is LIFO implement list.List.
-type Stack struct {
+// Stack is LIFO implement list.List of interface{} elements.
+type Stack = StackOf[interface{}]
+
+// StackOf is LIFO implement list.List of T elements.
+type StackOf[T any] struct {
 	ll *list.List
 }
 
 // New creates a Stack. which implement interface stack.Interface.
-func New() *Stack { return &Stack{list.New()} }
+func New() *Stack { return NewOf[interface{}]() }
+
+// NewOf creates a StackOf of T elements. which implement interface stack.Interface.
+func NewOf[T any]() *StackOf[T] { return &StackOf[T]{list.New()} }
 
 // Len returns the length of this priority queue.
-func (sf *Stack) Len() int { return sf.ll.Len() }
+func (sf *StackOf[T]) Len() int { return sf.ll.Len() }
 
 // IsEmpty returns true if this Stack contains no elements.
-func (sf *Stack) IsEmpty() bool { return sf.ll.Len() == 0 }
+func (sf *StackOf[T]) IsEmpty() bool { return sf.ll.Len() == 0 }
 
 // Clear removes all the elements from this Stack.
-func (sf *Stack) Clear() { sf.ll.Init() }
+func (sf *StackOf[T]) Clear() { sf.ll.Init() }
 
 // Push pushes an element into this Stack.
-func (sf *Stack) Push(val interface{}) { sf.ll.PushFront(val) }
+func (sf *StackOf[T]) Push(val T) { sf.ll.PushFront(val) }
 
 // Pop pops the element on the top of this Stack.
-func (sf *Stack) Pop() interface{} {
+// return the zero value if this Stack is empty.
+func (sf *StackOf[T]) Pop() (val T) {
 	if e := sf.ll.Front(); e != nil {
-		return sf.ll.Remove(e)
+		val, _ = sf.ll.Remove(e).(T)
 	}
-	return nil
+	return val
 }
 
 // Peek retrieves, but does not remove,
-// the element on the top of this Stack, or return nil if this Stack is empty.
-func (sf *Stack) Peek() interface{} {
+// the element on the top of this Stack, or return the zero value if this Stack is empty.
+func (sf *StackOf[T]) Peek() (val T) {
 	if e := sf.ll.Front(); e != nil {
-		return e.Value
+		val, _ = e.Value.(T)
+	}
+	return val
+}
+
+// All returns an iterator over the elements in this Stack from top to bottom.
+func (sf *StackOf[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := sf.ll.Front(); e != nil; e = e.Next() {
+			v, _ := e.Value.(T)
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements in this Stack from bottom to top.
+func (sf *StackOf[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := sf.ll.Back(); e != nil; e = e.Prev() {
+			v, _ := e.Value.(T)
+			if !yield(v) {
+				return
+			}
+		}
 	}
-	return nil
 }
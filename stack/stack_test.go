@@ -1,9 +1,12 @@
 package stack
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/thinkgos/container"
 )
 
 func TestStack(t *testing.T) {
@@ -46,3 +49,34 @@ func TestStack(t *testing.T) {
 	assert.True(t, s.IsEmpty())
 	assert.Zero(t, s.Len())
 }
+
+func TestStackOf(t *testing.T) {
+	var s container.StackOf[int] = NewOf[int]()
+	s.Push(5)
+	s.Push(6)
+
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, 6, s.Peek())
+	assert.Equal(t, 6, s.Pop())
+	assert.Equal(t, 5, s.Pop())
+	assert.Zero(t, s.Pop())
+	assert.Zero(t, s.Peek())
+	assert.True(t, s.IsEmpty())
+
+	// nil value of interface{} element
+	s1 := New()
+	s1.Push(nil)
+	assert.Nil(t, s1.Peek())
+	assert.Nil(t, s1.Pop())
+	assert.True(t, s1.IsEmpty())
+}
+
+func TestStackAll(t *testing.T) {
+	s := NewOf[int]()
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(s.All()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(s.Backward()))
+	assert.Equal(t, 3, s.Len())
+}
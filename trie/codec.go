@@ -0,0 +1,68 @@
+// Copyright [2020] [thinkgos]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trie
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/thinkgos/container"
+)
+
+// MarshalJSON implement json.Marshaler, the keys are encoded as a JSON array in lexicographic order.
+func (t *Trie) MarshalJSON() ([]byte, error) { return json.Marshal(t.keys()) }
+
+// UnmarshalJSON implement json.Unmarshaler, it replaces the keys of this trie.
+func (t *Trie) UnmarshalJSON(data []byte) error {
+	var keys []string
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+	t.reset(keys)
+	return nil
+}
+
+// MarshalBinary implement encoding.BinaryMarshaler, the keys are encoded by gob in lexicographic order.
+func (t *Trie) MarshalBinary() ([]byte, error) { return container.GobEncode(t.keys()) }
+
+// UnmarshalBinary implement encoding.BinaryUnmarshaler, it replaces the keys of this trie.
+func (t *Trie) UnmarshalBinary(data []byte) error {
+	var keys []string
+	if err := container.GobDecode(data, &keys); err != nil {
+		return err
+	}
+	t.reset(keys)
+	return nil
+}
+
+// GobEncode implement gob.GobEncoder.
+func (t *Trie) GobEncode() ([]byte, error) { return t.MarshalBinary() }
+
+// GobDecode implement gob.GobDecoder.
+func (t *Trie) GobDecode(data []byte) error { return t.UnmarshalBinary(data) }
+
+func (t *Trie) keys() []string {
+	if t.root == nil {
+		return []string{}
+	}
+	return slices.AppendSeq(make([]string, 0, t.size), t.All())
+}
+
+func (t *Trie) reset(keys []string) {
+	t.root, t.size = newNode(), 0
+	for _, key := range keys {
+		t.Insert(key)
+	}
+}
@@ -0,0 +1,52 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrieCodec(t *testing.T) {
+	tries := NewTrie()
+	for _, key := range []string{"tea", "ten", "to", "in", "inn"} {
+		tries.Insert(key)
+	}
+
+	data, err := json.Marshal(tries)
+	require.NoError(t, err)
+	require.JSONEq(t, `["in","inn","tea","ten","to"]`, string(data))
+	got := NewTrie()
+	got.Insert("replaced")
+	require.NoError(t, json.Unmarshal(data, got))
+	require.Equal(t, 5, got.Len())
+	require.Equal(t, slices.Collect(tries.All()), slices.Collect(got.All()))
+
+	data, err = tries.MarshalBinary()
+	require.NoError(t, err)
+	got = NewTrie()
+	require.NoError(t, got.UnmarshalBinary(data))
+	require.Equal(t, slices.Collect(tries.All()), slices.Collect(got.All()))
+
+	// gob with the zero value
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(tries))
+	var gotZero Trie
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&gotZero))
+	require.Equal(t, 5, gotZero.Len())
+	require.Equal(t, []interface{}{"tea", "ten"}, sorted(gotZero.MatchPrefix("te")))
+
+	require.Error(t, json.Unmarshal([]byte(`{}`), got))
+	require.Error(t, got.UnmarshalBinary([]byte("bad")))
+}
+
+func sorted(values []interface{}) []interface{} {
+	slices.SortFunc(values, func(a, b interface{}) int {
+		return strings.Compare(a.(string), b.(string))
+	})
+	return values
+}
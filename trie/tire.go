@@ -14,6 +14,11 @@
 
 package trie
 
+import (
+	"iter"
+	"slices"
+)
+
 // Node trie tree node container carries value and children.
 type Node struct {
 	exist bool
@@ -86,6 +91,43 @@ func (t *Trie) Walk(node *Node) (ret []interface{}) {
 	return ret
 }
 
+// All returns an iterator over the keys in this trie in lexicographic order.
+func (t *Trie) All() iter.Seq[string] {
+	return func(yield func(string) bool) { t.all(t.root, false, yield) }
+}
+
+// Backward returns an iterator over the keys in this trie in reverse lexicographic order.
+func (t *Trie) Backward() iter.Seq[string] {
+	return func(yield func(string) bool) { t.all(t.root, true, yield) }
+}
+
+// all walks the node in preorder, the children sorted by rune, or postorder in reverse,
+// it returns false if yield returns false.
+func (t *Trie) all(node *Node, reverse bool, yield func(string) bool) bool {
+	if !reverse && node.exist && !yield(node.value.(string)) {
+		return false
+	}
+
+	runes := make([]rune, 0, len(node.child))
+	for r := range node.child {
+		runes = append(runes, r)
+	}
+	slices.Sort(runes)
+	if reverse {
+		slices.Reverse(runes)
+	}
+	for _, r := range runes {
+		if !t.all(node.child[r], reverse, yield) {
+			return false
+		}
+	}
+
+	if reverse && node.exist && !yield(node.value.(string)) {
+		return false
+	}
+	return true
+}
+
 // find nodes corresponding to key.
 func (t *Trie) findNode(key string) (node *Node, index int) {
 	cur := t.root
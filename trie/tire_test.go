@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -52,3 +53,22 @@ func TestPrefixMatch(t *testing.T) {
 	matches = tries.MatchPrefix("hello")
 	require.Equal(t, 1, len(matches))
 }
+
+func TestTrieAll(t *testing.T) {
+	tries := NewTrie()
+	for _, key := range []string{"tea", "ten", "to", "t", "inn", "in", "A"} {
+		tries.Insert(key)
+	}
+	require.Equal(t, []string{"A", "in", "inn", "t", "tea", "ten", "to"}, slices.Collect(tries.All()))
+	require.Equal(t, []string{"to", "ten", "tea", "t", "inn", "in", "A"}, slices.Collect(tries.Backward()))
+
+	var got []string
+	for key := range tries.All() {
+		if key == "t" {
+			break
+		}
+		got = append(got, key)
+	}
+	require.Equal(t, []string{"A", "in", "inn"}, got)
+	require.Empty(t, slices.Collect(NewTrie().All()))
+}